@@ -0,0 +1,93 @@
+package chardet
+
+import "bytes"
+
+// Terminal escape sequence states.
+const (
+	ansiGround    = iota // text
+	ansiEscape           // after ESC
+	ansiCSI              // in a control sequence, ESC [
+	ansiOSC              // in an operating system command, ESC ]
+	ansiOSCEscape        // after ESC in an operating system command
+)
+
+// maxOSC is how long an operating system command may run before it is taken
+// to be unterminated and the text after it is read again.
+const maxOSC = 4096
+
+// ansiStripper removes the CSI and OSC terminal escape sequences that colour
+// and title terminal output, such as ESC [31m. Other escape sequences, among
+// them the designations of ISO-2022, are left in place. Sequences may be split
+// across calls to strip.
+type ansiStripper struct {
+	state int
+	osc   int
+}
+
+func (a *ansiStripper) reset() {
+	a.state = ansiGround
+	a.osc = 0
+}
+
+// strip returns buf without terminal escape sequences. It only copies buf
+// when it contains one.
+func (a *ansiStripper) strip(buf []byte) []byte {
+	if a.state == ansiGround && bytes.IndexByte(buf, 0x1B) < 0 {
+		return buf
+	}
+
+	out := make([]byte, 0, len(buf))
+	for _, c := range buf {
+		switch a.state {
+		case ansiGround:
+			if c == 0x1B {
+				a.state = ansiEscape
+				continue
+			}
+			out = append(out, c)
+		case ansiEscape:
+			switch c {
+			case '[':
+				a.state = ansiCSI
+			case ']':
+				a.state, a.osc = ansiOSC, 0
+			case 0x1B:
+				out = append(out, 0x1B)
+			default:
+				out = append(out, 0x1B, c)
+				a.state = ansiGround
+			}
+		case ansiCSI:
+			switch {
+			case c >= 0x40 && c <= 0x7E:
+				// the final byte ends the sequence
+				a.state = ansiGround
+			case c >= 0x20 && c <= 0x3F:
+				// parameter and intermediate bytes
+			default:
+				// a malformed sequence ends before the byte that breaks it
+				a.state = ansiGround
+				if c == 0x1B {
+					a.state = ansiEscape
+				} else {
+					out = append(out, c)
+				}
+			}
+		case ansiOSC, ansiOSCEscape:
+			a.osc++
+			switch {
+			case c == 0x07, a.state == ansiOSCEscape && c == '\\':
+				// BEL or ST ends the command
+				a.state = ansiGround
+			case c == 0x1B:
+				a.state = ansiOSCEscape
+			case a.osc > maxOSC:
+				a.state = ansiGround
+				out = append(out, c)
+			default:
+				a.state = ansiOSC
+			}
+		}
+	}
+	return out
+}
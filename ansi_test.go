@@ -0,0 +1,97 @@
+package chardet
+
+import (
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/lookup"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func TestDetectColouredLog(t *testing.T) {
+	const log = "\x1b]0;build #42\x07\x1b[1;32m[INFO]\x1b[0m Сборка запущена, ожидаем подключения к серверу.\n" +
+		"\x1b[33m[WARN]\x1b[0m Пользователь вошёл в систему без пароля.\n" +
+		"\x1b[31m[FAIL]\x1b[0m Тест завершился с ошибкой, смотрите журнал сборки.\n"
+
+	tests := []struct {
+		charset string
+	}{
+		{consts.UTF8},
+		{consts.Windows1251},
+		{consts.Koi8R},
+	}
+
+	for _, tt := range tests {
+		enc, err := lookup.LookupEncoding(tt.charset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf, err := enc.NewEncoder().Bytes([]byte(log))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res := Detect(buf); res.Charset != tt.charset {
+			t.Errorf("Detect(%s log) = %+v, want %s", tt.charset, res, tt.charset)
+		}
+
+		// split in the middle of every escape sequence
+		d := NewUniversalDetector(consts.AllLangFilter)
+		for i := 0; i < len(buf); i += 5 {
+			d.Feed(buf[i:min(i+5, len(buf))])
+		}
+		if res := d.GetResult(); res.Charset != tt.charset {
+			t.Errorf("Feed(%s log in pieces) = %+v, want %s", tt.charset, res, tt.charset)
+		}
+
+		// kept, the escapes that come before the text send it to the
+		// ISO-2022 probe, until the high bytes of the text move it on to the
+		// charset probes
+		d = NewUniversalDetector(consts.AllLangFilter)
+		d.KeepTerminalEscapes = true
+		for i := 0; i < len(buf); i += 5 {
+			d.Feed(buf[i:min(i+5, len(buf))])
+		}
+		if d.escCharsetProbe == nil || d.inputState != consts.HighByteInputState {
+			t.Errorf("Feed(%s log) with escapes kept: input state %v, want high bytes seen after escapes", tt.charset, d.inputState)
+		}
+	}
+}
+
+func TestDetectUTF16EscapeBytes(t *testing.T) {
+	// U+5B1B is 1B 5B in UTF-16LE, the start of a CSI escape
+	buf, err := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewEncoder().Bytes([]byte("嬛 Service started and listening on port 8080.\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the ESC alone is held back by the stripper, but not from the UTF-16
+	// probes
+	d := NewUniversalDetector(consts.AllLangFilter)
+	for i := range buf {
+		d.Feed(buf[i : i+1])
+	}
+	if res := d.GetResult(); res.Charset != consts.UTF16Le {
+		t.Errorf("Feed(UTF-16LE byte by byte) = %+v, want %s", res, consts.UTF16Le)
+	}
+}
+
+func TestANSIStripper(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"\x1b[31mred\x1b[0m", "red"},
+		{"\x1b[38;5;208morange", "orange"},
+		{"\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+		{"\x1b]0;title\x07text", "text"},
+		{"\x1b$B$3$s\x1b(B", "\x1b$B$3$s\x1b(B"},
+		{"\x1b[31\x01m", "\x01m"},
+	}
+
+	for _, tt := range tests {
+		var a ansiStripper
+		if got := a.strip([]byte(tt.in)); string(got) != tt.want {
+			t.Errorf("strip(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
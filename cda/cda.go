@@ -1,5 +1,9 @@
 package cda
 
+import (
+	"encoding"
+)
+
 type OrderFunc func([]byte) int
 
 type GetOrderFunc func([]byte) (int, int)
@@ -10,4 +14,10 @@ type Analyzer interface {
 	GotEnoughData() bool
 	GetConfidence() float64
 	CharSetName() string
+
+	// the state gathered from the data fed, to pause and resume detection
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	// Clone returns a copy that goes on independently of the analyser
+	Clone() Analyzer
 }
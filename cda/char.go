@@ -105,3 +105,8 @@ func (c *CharDistributionAnalysis) GotEnoughData() bool {
 func (c *CharDistributionAnalysis) CharSetName() string {
 	return ""
 }
+
+// SetEnoughDataThreshold sets how many characters are enough data.
+func (c *CharDistributionAnalysis) SetEnoughDataThreshold(n int) {
+	c.EnoughDataThreshold = n
+}
@@ -0,0 +1,64 @@
+package cda
+
+import (
+	"slices"
+)
+
+// The copies made by Clone share the frequency tables of the analyser, which
+// are never written, and have their order functions bound to themselves.
+
+func (b *Big5DistributionAnalysis) Clone() Analyzer {
+	c := *b
+	c.orderFunc = c.GetOrder
+	return &c
+}
+
+func (e *EUCJPDistributionAnalysis) Clone() Analyzer {
+	c := *e
+	c.orderFunc = c.GetOrder
+	return &c
+}
+
+func (e *EUCKRDistributionAnalysis) Clone() Analyzer {
+	c := *e
+	c.orderFunc = c.GetOrder
+	return &c
+}
+
+func (e *EUCTWDistributionAnalysis) Clone() Analyzer {
+	c := *e
+	c.orderFunc = c.GetOrder
+	return &c
+}
+
+func (g *GB2312DistributionAnalysis) Clone() Analyzer {
+	c := *g
+	c.orderFunc = c.GetOrder
+	return &c
+}
+
+func (j *JOHABDistributionAnalysis) Clone() Analyzer {
+	c := *j
+	c.orderFunc = c.GetOrder
+	return &c
+}
+
+func (s *SJISDistributionAnalysis) Clone() Analyzer {
+	c := *s
+	c.orderFunc = c.GetOrder
+	return &c
+}
+
+func (e *EUCJPContextAnalysis) Clone() Analyzer {
+	c := *e
+	c.relSample = slices.Clone(e.relSample)
+	c.orderFunc = c.GetOrder
+	return &c
+}
+
+func (s *SJISContextAnalysis) Clone() Analyzer {
+	c := *s
+	c.relSample = slices.Clone(s.relSample)
+	c.orderFunc = c.GetOrder
+	return &c
+}
@@ -0,0 +1,70 @@
+package cda
+
+import (
+	"github.com/wlynxg/chardet/internal/state"
+)
+
+// MarshalBinary encodes the counts gathered from the characters fed so far.
+func (c *CharDistributionAnalysis) MarshalBinary() ([]byte, error) {
+	var e state.Encoder
+	e.Bool(c.done)
+	e.Int(c.totalChars)
+	e.Int(c.freqChars)
+	return e.Bytes(), nil
+}
+
+// UnmarshalBinary restores the counts encoded by MarshalBinary.
+func (c *CharDistributionAnalysis) UnmarshalBinary(data []byte) error {
+	d := state.NewDecoder(data)
+	c.done = d.Bool()
+	c.totalChars = d.Int()
+	c.freqChars = d.Int()
+	return d.Err()
+}
+
+// MarshalBinary encodes the counts gathered from the characters fed so far.
+func (j *JapaneseContextAnalysis) MarshalBinary() ([]byte, error) {
+	var e state.Encoder
+	e.Int(j.totalRel)
+	e.Raw(j.relSample)
+	e.Int(j.needToSkipCharNum)
+	e.Int(j.lastCharOrder)
+	e.Bool(j.done)
+	return e.Bytes(), nil
+}
+
+// UnmarshalBinary restores the counts encoded by MarshalBinary.
+func (j *JapaneseContextAnalysis) UnmarshalBinary(data []byte) error {
+	d := state.NewDecoder(data)
+	j.totalRel = d.Int()
+	relSample := d.Raw()
+	// a character is skipped at most two bytes past the end of the data fed
+	j.needToSkipCharNum = d.Range(0, 3)
+	j.lastCharOrder = d.Range(-1, len(j.jp2CharContext))
+	j.done = d.Bool()
+	if err := d.Err(); err != nil {
+		return err
+	}
+	if len(relSample) != j.NumOfCategory {
+		return state.ErrCorrupt
+	}
+	j.relSample = relSample
+	return nil
+}
+
+// MarshalBinary encodes the counts gathered from the characters fed so far
+// and whether CP932 characters were seen.
+func (s *SJISContextAnalysis) MarshalBinary() ([]byte, error) {
+	var e state.Encoder
+	e.Marshal(&s.JapaneseContextAnalysis)
+	e.String(s.charsetName)
+	return e.Bytes(), e.Err()
+}
+
+// UnmarshalBinary restores the state encoded by MarshalBinary.
+func (s *SJISContextAnalysis) UnmarshalBinary(data []byte) error {
+	d := state.NewDecoder(data)
+	d.Unmarshal(&s.JapaneseContextAnalysis)
+	s.charsetName = d.String()
+	return d.Err()
+}
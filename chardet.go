@@ -17,15 +17,32 @@ func Detect(buf []byte) Result {
 func DetectAll(buf []byte) []Result {
 	d := NewUniversalDetector(consts.UnknownLangFilter)
 	d.Feed(buf)
-	result := d.GetResult()
+	return d.allResults()
+}
+
+// DetectAllWith returns the possible encodings of buf, as DetectAll does, with
+// a detector configured by opts.
+func DetectAllWith(buf []byte, opts ...Option) ([]Result, error) {
+	d, err := New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	d.Feed(buf)
+	return d.allResults(), nil
+}
+
+// allResults returns the results of the probes confident enough, the most
+// confident first, or the final result.
+func (u *UniversalDetector) allResults() []Result {
+	result := u.Peek()
 
-	if d.inputState == consts.HighByteInputState {
+	if u.inputState == consts.HighByteInputState && !result.Binary {
 		var (
 			results []Result
 			probes  []probe.Probe
 		)
 
-		for _, p := range d.charsetProbes {
+		for _, p := range u.charsetProbes {
 			switch rp := p.(type) {
 			case *probe.CharSetGroupProbe:
 				probes = append(probes, rp.Probes()...)
@@ -35,16 +52,8 @@ func DetectAll(buf []byte) []Result {
 		}
 
 		for _, setProbe := range probes {
-			if setProbe.GetConfidence() > d.MinimumThreshold {
-				charsetName := setProbe.CharSetName()
-				if d.hasWinBytes {
-					// Use Windows encoding name instead of ISO-8859 if we saw any
-					// extra Windows-specific bytes
-					if n, ok := d.IsoWinMap[setProbe.CharSetName()]; ok {
-						charsetName = n
-					}
-				}
-				results = append(results, newResult(charsetName, setProbe.GetConfidence(), setProbe.Language()))
+			if res := u.weigh(setProbe); res.Confidence > u.MinimumThreshold && u.permits(res) {
+				results = append(results, res)
 			}
 		}
 
@@ -0,0 +1,238 @@
+package chardet
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/declaration"
+	"github.com/wlynxg/chardet/probe"
+)
+
+// sameCharset reports whether two charset names are the same, ignoring case,
+// hyphens and underscores, so that "utf8" names UTF-8 and "shift-jis"
+// Shift_JIS.
+func sameCharset(a, b string) bool {
+	squash := func(s string) string {
+		return strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(s))
+	}
+	return squash(a) == squash(b)
+}
+
+// listsCharset reports whether labels name the charset of res. UTF-8 names
+// UTF-8-SIG too.
+func listsCharset(labels []string, res Result) bool {
+	for _, label := range labels {
+		for _, name := range []string{res.Charset, res.Encoding, strings.TrimSuffix(res.Charset, "-SIG")} {
+			if name != "" && sameCharset(label, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// knownCharsets are the charsets named in consts, which results taken from a
+// declaration are reported as.
+var knownCharsets = []string{
+	consts.Ascii, consts.UTF8, consts.UTF16, consts.UTF16Le, consts.UTF16Be,
+	consts.UTF32, consts.UTF32Le, consts.UTF32Be,
+	consts.GB2312, consts.HzGB2312, consts.ShiftJis, consts.Big5, consts.Johab,
+	consts.Koi8R, consts.TIS620, consts.EucTw, consts.EucKr, consts.EucJp,
+	consts.CP932, consts.CP949,
+	consts.MacCyrillic, consts.MacRoman, consts.MacCentralEurope, consts.MacGreek,
+	consts.MacTurkish, consts.MacIcelandic, consts.MacHebrew, consts.MacArabic,
+	consts.Windows1250, consts.Windows1251, consts.Windows1252, consts.Windows1253,
+	consts.Windows1254, consts.Windows1255, consts.Windows1256, consts.Windows1257,
+	consts.ISO88591, consts.ISO88592, consts.ISO88595, consts.ISO88596,
+	consts.ISO88597, consts.ISO88598, consts.ISO88598I, consts.ISO88599, consts.ISO885913,
+	consts.ISO2022CN, consts.ISO2022JP, consts.ISO2022KR,
+	consts.IBM855, consts.IBM866,
+	consts.Armscii8, consts.GeorgianPS, consts.GeorgianAcademy,
+	consts.ISCII, consts.TSCII,
+}
+
+// declaredCharset returns the consts name of the encoding decl declares: that
+// of its label, such as ISO-8859-1 for "latin1", or else that of its WHATWG
+// name, such as Windows-1252 for "cp1252". Encodings consts does not name keep
+// their WHATWG name.
+func declaredCharset(decl declaration.Declaration) string {
+	for _, name := range []string{decl.Label, decl.Charset} {
+		for _, c := range knownCharsets {
+			if sameCharset(name, c) || sameCharset(name, consts.CanonicalCharset(c)) {
+				return c
+			}
+		}
+	}
+	for _, c := range knownCharsets {
+		if name, ok := declaration.Normalize(consts.CanonicalCharset(c)); ok && name == decl.Charset {
+			return c
+		}
+	}
+	return decl.Charset
+}
+
+// restricted reports whether Charsets or ExcludeCharsets rule out charsets.
+func (u *UniversalDetector) restricted() bool {
+	return len(u.Charsets) > 0 || len(u.ExcludeCharsets) > 0
+}
+
+// permits reports whether res may be reported under Charsets and
+// ExcludeCharsets. Binary results and the lack of an answer carry no charset
+// and are always permitted.
+func (u *UniversalDetector) permits(res Result) bool {
+	if res.Binary || res.Charset == "" {
+		return true
+	}
+	if len(u.Charsets) > 0 && !listsCharset(u.Charsets, res) {
+		return false
+	}
+	return !listsCharset(u.ExcludeCharsets, res)
+}
+
+// probeCharsets returns the charsets p can report, Windows renames, the
+// Hebrew orders and the Microsoft variant of Shift_JIS included.
+func (u *UniversalDetector) probeCharsets(p probe.Probe) []string {
+	names := []string{p.CharSetName()}
+	if n, ok := u.IsoWinMap[names[0]]; ok {
+		names = append(names, n)
+	}
+	switch names[0] {
+	case consts.ISO88598, consts.Windows1255:
+		names = append(names, consts.ISO88598, consts.ISO88598I, consts.Windows1255)
+	case consts.ShiftJis, consts.CP932:
+		names = append(names, consts.ShiftJis, consts.CP932)
+	}
+	return names
+}
+
+// permitsProbe reports whether any of the charsets p can report is permitted.
+func (u *UniversalDetector) permitsProbe(p probe.Probe) bool {
+	for _, name := range u.probeCharsets(p) {
+		if u.permits(newResult(name, 0, "")) {
+			return true
+		}
+	}
+	return false
+}
+
+// newCharsetProbes returns the probes of the encodings that need high bytes,
+// leaving out those of charsets ruled out.
+func (u *UniversalDetector) newCharsetProbes() []probe.Probe {
+	probes := []probe.Probe{probe.MBCGroupProbe(u.filter)}
+	// If we're checking non-CJK encodings, use single-byte probe
+	if sbcs := probe.NewSBCSGroupProbeWithFilter(u.filter); len(sbcs.Probes()) > 0 {
+		probes = append(probes, sbcs)
+	}
+	probes = append(probes, probe.NewLatinProbes(u.filter)...)
+
+	if u.restricted() {
+		kept := probes[:0]
+		for _, p := range probes {
+			if g, ok := p.(interface {
+				Keep(func(probe.Probe) bool) int
+			}); ok {
+				if g.Keep(u.permitsProbe) > 0 {
+					kept = append(kept, p)
+				}
+			} else if u.permitsProbe(p) {
+				kept = append(kept, p)
+			}
+		}
+		probes = kept
+	}
+
+	for _, p := range probes {
+		probe.Tune(p, u.Tuning)
+		probe.SetLogger(p, u.Logger)
+		probe.SetObserver(p, u.Observer)
+	}
+	return probes
+}
+
+// probeSettings are the settings that decide which charset probes are created
+// and how they are tuned. The probes keep them when the settings change.
+type probeSettings struct {
+	charsets, excludeCharsets []string
+	isoWinMap                 map[string]string
+	tuning                    probe.Tuning
+}
+
+// currentProbeSettings returns a copy of the settings the charset probes
+// would be created with now.
+func (u *UniversalDetector) currentProbeSettings() probeSettings {
+	return probeSettings{
+		charsets:        slices.Clone(u.Charsets),
+		excludeCharsets: slices.Clone(u.ExcludeCharsets),
+		isoWinMap:       maps.Clone(u.IsoWinMap),
+		tuning:          u.Tuning,
+	}
+}
+
+// newCharsetProbesWith returns the charset probes created under s, whatever
+// the settings are now.
+func (u *UniversalDetector) newCharsetProbesWith(s probeSettings) []probe.Probe {
+	w := *u
+	w.Charsets, w.ExcludeCharsets, w.IsoWinMap, w.Tuning = s.charsets, s.excludeCharsets, s.isoWinMap, s.tuning
+	return w.newCharsetProbes()
+}
+
+// asciiResult returns the result for 7-bit text: ASCII or, when ASCII is ruled
+// out, the first charset permitted that reads the text the same.
+func (u *UniversalDetector) asciiResult() Result {
+	res := newResult(consts.Ascii, 1.0, "")
+	if u.permits(res) {
+		return res
+	}
+
+	for _, p := range u.newCharsetProbes() {
+		probes := []probe.Probe{p}
+		if g, ok := p.(interface{ Probes() []probe.Probe }); ok {
+			probes = g.Probes()
+		}
+		for _, p := range probes {
+			for _, name := range u.probeCharsets(p) {
+				if res := newResult(name, 1.0, ""); u.permits(res) {
+					return res
+				}
+			}
+		}
+	}
+	return Result{}
+}
+
+// permittedProbes returns the charset probes whose current answer is
+// permitted, with the groups replaced by their active members: a group only
+// names its best probe, which may be ruled out.
+func (u *UniversalDetector) permittedProbes() []probe.Probe {
+	var probes []probe.Probe
+	for _, p := range u.charsetProbes {
+		members := []probe.Probe{p}
+		if g, ok := p.(interface{ Probes() []probe.Probe }); ok {
+			members = members[:0]
+			for _, m := range g.Probes() {
+				if m.IsActive() {
+					members = append(members, m)
+				}
+			}
+		}
+		for _, m := range members {
+			if u.permits(u.probeResult(m)) {
+				probes = append(probes, m)
+			}
+		}
+	}
+	return probes
+}
+
+// accept makes res the result and ends detection, unless its charset is ruled
+// out.
+func (u *UniversalDetector) accept(res Result) bool {
+	if !u.permits(res) {
+		return false
+	}
+	u.result = res
+	u.done = true
+	return true
+}
@@ -0,0 +1,59 @@
+package chardet
+
+import (
+	"os"
+	"testing"
+)
+
+func TestDetectCharsets(t *testing.T) {
+	sjis, err := os.ReadFile("test/testdata/SHIFT_JIS/_chromium_Shift-JIS_with_no_encoding_specified.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	russian, err := os.ReadFile("test/testdata/windows-1251-russian/_chromium_windows-1251_with_no_encoding_specified.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	utf16, err := os.ReadFile("test/testdata/UTF-16/bom-utf-16-le.srt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	japanese := []string{"utf8", "shift-jis", "EUC-JP"}
+	tests := []struct {
+		name    string
+		buf     []byte
+		opt     Option
+		charset string
+	}{
+		{"Shift_JIS", sjis, WithCharsets(japanese...), "Shift_JIS"},
+		{"ASCII", []byte("plain text"), WithCharsets(japanese...), "UTF-8"},
+		{"ASCII allowed", []byte("plain text"), WithCharsets("ascii", "utf-8"), "US-ASCII"},
+		{"UTF-16 BOM", utf16, WithCharsets("UTF-8"), ""},
+		{"Russian excluded", russian, WithoutCharsets("Windows-1251"), ""},
+		{"Russian not allowed", russian, WithCharsets(japanese...), ""},
+	}
+
+	for _, tt := range tests {
+		d, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d.Feed(tt.buf)
+		res := d.GetResult()
+
+		if tt.charset != "" && res.Charset != tt.charset {
+			t.Errorf("Detect(%s) = %+v, want %s", tt.name, res, tt.charset)
+		}
+		if !d.permits(res) {
+			t.Errorf("Detect(%s) = %+v, a charset ruled out", tt.name, res)
+		}
+	}
+
+	// only the probes of the named charsets are run
+	d, _ := New(WithCharsets(japanese...))
+	d.Feed(sjis)
+	if probes := d.leafProbes(); len(probes) != 3 {
+		t.Errorf("%d probes run, want 3", len(probes))
+	}
+}
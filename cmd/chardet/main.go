@@ -0,0 +1,76 @@
+// Command chardet prints the character encoding of files, or of the standard
+// input when no file is named.
+//
+// Usage:
+//
+//	chardet [-explain] [file ...]
+//
+// With -explain, it prints for each file what every probe concluded and how
+// the result was picked.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/wlynxg/chardet"
+)
+
+func main() {
+	explain := flag.Bool("explain", false, "show what every probe concluded")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: chardet [-explain] [file ...]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	status := 0
+	if flag.NArg() == 0 {
+		if err := detect(os.Stdout, "<stdin>", os.Stdin, *explain); err != nil {
+			fmt.Fprintln(os.Stderr, "chardet:", err)
+			status = 1
+		}
+	}
+	for _, name := range flag.Args() {
+		f, err := os.Open(name)
+		if err == nil {
+			err = detect(os.Stdout, name, f, *explain)
+			f.Close()
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "chardet:", err)
+			status = 1
+		}
+	}
+	os.Exit(status)
+}
+
+func detect(w io.Writer, name string, r io.Reader, explain bool) error {
+	d, err := chardet.New()
+	if err != nil {
+		return err
+	}
+	if _, err := d.ReadFrom(r); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	if explain {
+		_, err = fmt.Fprintf(w, "%s:\n%s\n", name, d.Explain())
+		return err
+	}
+
+	res := d.GetResult()
+	switch {
+	case res.Binary:
+		_, err = fmt.Fprintf(w, "%s: binary (%s)\n", name, res.Reason)
+	case res.Charset == "":
+		_, err = fmt.Fprintf(w, "%s: unknown\n", name)
+	case res.Language != "":
+		_, err = fmt.Fprintf(w, "%s: %s (%s) with confidence %.2f\n", name, res.Charset, res.Language, res.Confidence)
+	default:
+		_, err = fmt.Fprintf(w, "%s: %s with confidence %.2f\n", name, res.Charset, res.Confidence)
+	}
+	return err
+}
@@ -6,6 +6,13 @@ var legacyToCanonical = map[string]string{
 	Johab:       "KS_C_5601-1987",
 	MacRoman:    "macintosh",
 	MacCyrillic: "x-mac-cyrillic",
+
+	MacCentralEurope: "x-mac-ce",
+	MacGreek:         "x-mac-greek",
+	MacTurkish:       "x-mac-turkish",
+	MacIcelandic:     "x-mac-icelandic",
+	MacHebrew:        "x-mac-hebrew",
+	MacArabic:        "x-mac-arabic",
 }
 
 var canonicalToLegacy map[string]string
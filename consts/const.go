@@ -10,6 +10,19 @@ const (
 	Bulgarian = "Bulgarian"
 	Thai      = "Thai"
 	Turkish   = "Turkish"
+	Armenian  = "Armenian"
+	Georgian  = "Georgian"
+	Arabic    = "Arabic"
+	Hindi     = "Hindi"
+	Bengali   = "Bengali"
+	Tamil     = "Tamil"
+	Telugu    = "Telugu"
+	Assamese  = "Assamese"
+	Oriya     = "Oriya"
+	Kannada   = "Kannada"
+	Malayalam = "Malayalam"
+	Gujarati  = "Gujarati"
+	Punjabi   = "Punjabi"
 )
 
 const (
@@ -31,8 +44,14 @@ const (
 	Koi8R    = "KOI8-R"
 	TIS620   = "TIS-620"
 
-	MacCyrillic = "MacCyrillic"
-	MacRoman    = "MacRoman"
+	MacCyrillic      = "MacCyrillic"
+	MacRoman         = "MacRoman"
+	MacCentralEurope = "MacCentralEurope"
+	MacGreek         = "MacGreek"
+	MacTurkish       = "MacTurkish"
+	MacIcelandic     = "MacIcelandic"
+	MacHebrew        = "MacHebrew"
+	MacArabic        = "MacArabic"
 
 	EucTw = "EUC-TW"
 	EucKr = "EUC-KR"
@@ -56,6 +75,7 @@ const (
 	ISO88596  = "ISO-8859-6"
 	ISO88597  = "ISO-8859-7"
 	ISO88598  = "ISO-8859-8"
+	ISO88598I = "ISO-8859-8-I"
 	ISO88599  = "ISO-8859-9"
 	ISO885913 = "ISO-8859-13"
 	ISO2022CN = "ISO-2022-CN"
@@ -66,6 +86,13 @@ const (
 
 	IBM855 = "IBM855"
 	IBM866 = "IBM866"
+
+	Armscii8        = "ARMSCII-8"
+	GeorgianPS      = "Georgian-PS"
+	GeorgianAcademy = "Georgian-Academy"
+
+	ISCII = "ISCII"
+	TSCII = "TSCII"
 )
 
 const (
@@ -79,7 +106,10 @@ const (
 )
 
 // LangFilter represents the different language filters we can apply to a "UniversalDetector".
-type LangFilter byte
+// Each language has a bit of its own, except the Latin-script languages, which
+// the Latin probes tell apart by region only. LangFilter used to be a byte, with
+// a single bit for all the non-CJK languages.
+type LangFilter uint64
 
 const (
 	UnknownLangFilter           LangFilter = 0
@@ -87,13 +117,90 @@ const (
 	ChineseTraditionalLangFilter
 	JapaneseLangFilter
 	KoreanLangFilter
-	NonCjkLangFilter
-	ChineseLangFilter = ChineseSimplifiedLangFilter | ChineseTraditionalLangFilter
-	CjkLangFilter     = ChineseLangFilter | JapaneseLangFilter | KoreanLangFilter
-	AllLangFilter     = ChineseSimplifiedLangFilter | ChineseTraditionalLangFilter |
-		JapaneseLangFilter | KoreanLangFilter | NonCjkLangFilter
+	// legacyNonCjkLangFilter is the bit NonCjkLangFilter had when the non-CJK
+	// languages had no bits of their own. Expand turns it into their bits.
+	legacyNonCjkLangFilter
+	RussianLangFilter
+	BulgarianLangFilter
+	GreekLangFilter
+	HebrewLangFilter
+	ThaiLangFilter
+	TurkishLangFilter
+	ArmenianLangFilter
+	GeorgianLangFilter
+	ArabicLangFilter
+	HindiLangFilter
+	BengaliLangFilter
+	TamilLangFilter
+	TeluguLangFilter
+	AssameseLangFilter
+	OriyaLangFilter
+	KannadaLangFilter
+	MalayalamLangFilter
+	GujaratiLangFilter
+	PunjabiLangFilter
+	// WesternEuropeanLangFilter selects ISO-8859-1, Windows-1252, MacRoman
+	// and MacIcelandic
+	WesternEuropeanLangFilter
+	// CentralEuropeanLangFilter selects MacCentralEurope
+	CentralEuropeanLangFilter
+)
+
+const (
+	ChineseLangFilter  = ChineseSimplifiedLangFilter | ChineseTraditionalLangFilter
+	CjkLangFilter      = ChineseLangFilter | JapaneseLangFilter | KoreanLangFilter
+	CyrillicLangFilter = RussianLangFilter | BulgarianLangFilter
+	IndicLangFilter    = HindiLangFilter | BengaliLangFilter | TamilLangFilter | TeluguLangFilter |
+		AssameseLangFilter | OriyaLangFilter | KannadaLangFilter | MalayalamLangFilter |
+		GujaratiLangFilter | PunjabiLangFilter
+	LatinLangFilter  = WesternEuropeanLangFilter | CentralEuropeanLangFilter | TurkishLangFilter
+	NonCjkLangFilter = CyrillicLangFilter | GreekLangFilter | HebrewLangFilter | ThaiLangFilter |
+		TurkishLangFilter | ArmenianLangFilter | GeorgianLangFilter | ArabicLangFilter |
+		IndicLangFilter | LatinLangFilter
+	AllLangFilter = CjkLangFilter | NonCjkLangFilter
 )
 
+var languageFilters = map[string]LangFilter{
+	Chinese:   ChineseLangFilter,
+	Japanese:  JapaneseLangFilter,
+	Korean:    KoreanLangFilter,
+	Russian:   RussianLangFilter,
+	Bulgarian: BulgarianLangFilter,
+	Greek:     GreekLangFilter,
+	Hebrew:    HebrewLangFilter,
+	Thai:      ThaiLangFilter,
+	Turkish:   TurkishLangFilter,
+	Armenian:  ArmenianLangFilter,
+	Georgian:  GeorgianLangFilter,
+	Arabic:    ArabicLangFilter,
+	Hindi:     HindiLangFilter,
+	Bengali:   BengaliLangFilter,
+	Tamil:     TamilLangFilter,
+	Telugu:    TeluguLangFilter,
+	Assamese:  AssameseLangFilter,
+	Oriya:     OriyaLangFilter,
+	Kannada:   KannadaLangFilter,
+	Malayalam: MalayalamLangFilter,
+	Gujarati:  GujaratiLangFilter,
+	Punjabi:   PunjabiLangFilter,
+}
+
+// Expand returns f with the bit NonCjkLangFilter had in older releases
+// replaced by the bits of all the non-CJK languages, so that filters stored
+// as numbers keep their meaning.
+func (f LangFilter) Expand() LangFilter {
+	if f&legacyNonCjkLangFilter != 0 {
+		f |= NonCjkLangFilter
+	}
+	return f
+}
+
+// LanguageFilter returns the filter that selects language, or
+// UnknownLangFilter for a language without a bit of its own.
+func LanguageFilter(language string) LangFilter {
+	return languageFilters[language]
+}
+
 // InputState represents the different states a universal detector can be in.
 type InputState byte
 
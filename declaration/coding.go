@@ -0,0 +1,80 @@
+package declaration
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+var (
+	// PEP 263 coding declarations and Ruby magic comments, as in
+	// "# -*- coding: latin-1 -*-" or "# encoding: utf-8"
+	codingCookie = regexp.MustCompile(`^[ \t\f]*#.*?coding[:=][ \t]*([-\w.]+)`)
+	// Emacs file variables, as in "/* -*- mode: c; coding: utf-8 -*- */"
+	emacsVariables = regexp.MustCompile(`-\*-(.*?)-\*-`)
+	emacsCoding    = regexp.MustCompile(`(?:^|;)[ \t]*coding:[ \t]*([-\w.]+)`)
+	// Vim modelines, as in "vim: set fileencoding=cp1251 :" or "vi:fenc=utf-8"
+	vimModeline = regexp.MustCompile(`(?:^|[ \t])(?:vi|vim\d*|ex):(?:.*?[ \t:])?(?:fileencoding|fenc)=([-\w.]+)`)
+)
+
+// vimModelines is how many lines Vim searches for modelines by default.
+const vimModelines = 5
+
+// SourceCoding extracts the encoding named by a coding cookie or an editor
+// modeline in the first lines of head. Like the interpreters and editors that
+// read them, a cookie must be on the first line, or on the second one after a
+// comment such as a #! line.
+func SourceCoding(head []byte) (Declaration, bool) {
+	if label, syntax, ok := sourceCoding(head); ok {
+		return New(label, syntax), true
+	}
+	return Declaration{}, false
+}
+
+func sourceCoding(b []byte) (string, Syntax, bool) {
+	lines := bytes.SplitN(b, []byte("\n"), vimModelines+1)
+	if len(lines) > vimModelines {
+		lines = lines[:vimModelines]
+	}
+
+	cookieLines := lines[:1]
+	if len(lines) > 1 && isCommentLine(lines[0]) {
+		cookieLines = lines[:2]
+	}
+	for _, line := range cookieLines {
+		// a # comment in the Emacs syntax is read as a PEP 263 declaration
+		if m := codingCookie.FindSubmatch(line); m != nil {
+			return string(m[1]), Cookie, true
+		}
+		if m := emacsVariables.FindSubmatch(line); m != nil {
+			if c := emacsCoding.FindSubmatch(m[1]); c != nil {
+				return emacsCodingSystem(string(c[1])), Emacs, true
+			}
+		}
+	}
+
+	for _, line := range lines {
+		if m := vimModeline.FindSubmatch(line); m != nil {
+			return string(m[1]), Vim, true
+		}
+	}
+	return "", "", false
+}
+
+// isCommentLine reports whether line is blank or a # comment, which may come
+// before a coding cookie on the second line.
+func isCommentLine(line []byte) bool {
+	line = bytes.TrimLeft(line, " \t\f\r")
+	return len(line) == 0 || line[0] == '#'
+}
+
+// emacsCodingSystem strips the end-of-line variant from an Emacs coding
+// system, as in "utf-8-unix".
+func emacsCodingSystem(name string) string {
+	for _, eol := range []string{"-unix", "-dos", "-mac"} {
+		if trimmed, ok := strings.CutSuffix(name, eol); ok {
+			return trimmed
+		}
+	}
+	return name
+}
@@ -0,0 +1,29 @@
+package declaration
+
+import (
+	"bytes"
+)
+
+// CSSCharset extracts the encoding of the @charset rule that opens head. As
+// in the CSS Syntax specification, the rule must be written exactly as
+// @charset "label";
+func CSSCharset(head []byte) (Declaration, bool) {
+	if label, ok := cssCharset(head); ok {
+		return New(label, CSS), true
+	}
+	return Declaration{}, false
+}
+
+func cssCharset(b []byte) (string, bool) {
+	const prefix = `@charset "`
+
+	if !bytes.HasPrefix(b, []byte(prefix)) {
+		return "", false
+	}
+	value := b[len(prefix):]
+	end := bytes.Index(value, []byte(`";`))
+	if end <= 0 || bytes.IndexByte(value[:end], '"') >= 0 {
+		return "", false
+	}
+	return string(value[:end]), true
+}
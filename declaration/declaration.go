@@ -0,0 +1,144 @@
+// Package declaration finds the character encoding a document declares about
+// itself: the charset of an HTML <meta> element, the encoding of an XML
+// declaration, a CSS @charset rule, the coding cookie or editor modeline of a
+// source file, the code page of an RTF document, the charset of a gettext PO
+// header or the SET NAMES statement of a MySQL dump.
+package declaration
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// Syntax names the kind of declaration an encoding was found in.
+type Syntax string
+
+const (
+	HTML Syntax = "html"
+	XML  Syntax = "xml"
+	CSS  Syntax = "css"
+
+	// Cookie is a PEP 263 coding declaration or a Ruby magic comment
+	Cookie Syntax = "cookie"
+	// Emacs is the coding variable of an Emacs -*- line
+	Emacs Syntax = "emacs"
+	// Vim is the fileencoding option of a Vim modeline
+	Vim Syntax = "vim"
+
+	// RTF is the \ansicpg or \mac control word of an RTF header
+	RTF Syntax = "rtf"
+	// PO is the Content-Type field of a gettext PO header entry
+	PO Syntax = "po"
+	// SQL is a SET NAMES statement of a MySQL dump
+	SQL Syntax = "sql"
+)
+
+// Hint reports whether the declaration is a comment addressed to editors and
+// interpreters rather than part of the document syntax.
+func (s Syntax) Hint() bool {
+	return s == Cookie || s == Emacs || s == Vim
+}
+
+// Declaration is an encoding declared inside a document.
+type Declaration struct {
+	// Charset is the declared encoding under its WHATWG name, or the label
+	// itself when the WHATWG Encoding Standard does not know it
+	Charset string
+	// Label is the encoding label as written in the document
+	Label string
+	// Syntax is the kind of declaration the label was found in
+	Syntax Syntax
+}
+
+// PrescanLength is how many bytes at the start of a document are searched for
+// a declaration, as in the WHATWG prescan algorithm.
+const PrescanLength = 1024
+
+// Extractor finds the encoding a kind of document declares in its first
+// PrescanLength bytes, if head is such a document.
+type Extractor func(head []byte) (Declaration, bool)
+
+// DefaultExtractors returns the extractors Sniff tries, in order. The formats
+// recognised by how they open come first and the HTML prescan, which
+// searches the whole head, comes last.
+func DefaultExtractors() []Extractor {
+	return []Extractor{
+		XMLDeclaration,
+		CSSCharset,
+		RTFCodePage,
+		POHeader,
+		SQLSetNames,
+		SourceCoding,
+		HTMLMeta,
+	}
+}
+
+// Sniff returns the encoding declared at the start of buf by the first of the
+// DefaultExtractors that finds one.
+func Sniff(buf []byte) (Declaration, bool) {
+	return SniffWith(buf, DefaultExtractors())
+}
+
+// SniffWith returns the encoding declared at the start of buf by the first of
+// extractors that finds one. A UTF-8 BOM is skipped and only the first
+// PrescanLength bytes are searched.
+func SniffWith(buf []byte, extractors []Extractor) (Declaration, bool) {
+	buf = bytes.TrimPrefix(buf, []byte("\xEF\xBB\xBF"))
+	if len(buf) > PrescanLength {
+		buf = buf[:PrescanLength]
+	}
+
+	for _, extract := range extractors {
+		if decl, ok := extract(buf); ok {
+			return decl, true
+		}
+	}
+	return Declaration{}, false
+}
+
+// New returns the declaration of label found in a syntax, with the label
+// normalized to its WHATWG name when the Encoding Standard knows it.
+func New(label string, syntax Syntax) Declaration {
+	charset, ok := Normalize(label)
+	if !ok {
+		charset = label
+	}
+	return Declaration{Charset: charset, Label: label, Syntax: syntax}
+}
+
+// Normalize returns the WHATWG name of an encoding label, so that labels of
+// the same encoding compare equal: "latin1", "ISO-8859-1" and "windows-1252"
+// are all "windows-1252". The Python and Emacs spellings of labels, such as
+// "euc_jp" and "latin-1", are understood as well.
+func Normalize(label string) (string, bool) {
+	label = strings.TrimSpace(label)
+	enc, err := htmlindex.Get(label)
+	if err != nil {
+		enc, err = htmlindex.Get(strings.ReplaceAll(label, "_", "-"))
+	}
+	if err != nil {
+		enc, err = htmlindex.Get(strings.NewReplacer("_", "", "-", "").Replace(label))
+	}
+	if err != nil {
+		return "", false
+	}
+	name, err := htmlindex.Name(enc)
+	if err != nil {
+		return "", false
+	}
+	return name, true
+}
+
+// isSpace reports whether c is ASCII whitespace in the WHATWG sense.
+func isSpace(c byte) bool {
+	return c == '\t' || c == '\n' || c == '\f' || c == '\r' || c == ' '
+}
+
+func toLower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + ('a' - 'A')
+	}
+	return c
+}
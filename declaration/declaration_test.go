@@ -0,0 +1,89 @@
+package declaration
+
+import "testing"
+
+func TestSniff(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want Declaration
+		ok   bool
+	}{
+		{"meta charset", `<html><head><meta charset="Shift_JIS">`, Declaration{"shift_jis", "shift_jis", HTML}, true},
+		{"http-equiv", `<meta http-equiv="Content-Type" content="text/html; charset=latin1">`, Declaration{"windows-1252", "latin1", HTML}, true},
+		{"content without pragma", `<meta content="text/html; charset=latin1">`, Declaration{}, false},
+		{"commented out", `<!-- <meta charset="koi8-r"> --><meta charset="gbk">`, Declaration{"gbk", "gbk", HTML}, true},
+		{"in attribute", `<a title='<meta charset="koi8-r">'><meta charset=euc-kr>`, Declaration{"euc-kr", "euc-kr", HTML}, true},
+		{"utf-16 in meta", `<meta charset="utf-16le">`, Declaration{"utf-8", "utf-8", HTML}, true},
+		{"unknown label", `<meta charset="no-such-charset">`, Declaration{}, false},
+		{"xml", `<?xml version="1.0" encoding='ISO-8859-2'?><root/>`, Declaration{"iso-8859-2", "ISO-8859-2", XML}, true},
+		{"xml with BOM", "\xEF\xBB\xBF<?xml version=\"1.0\" encoding=\"MacCyrillic\"?>", Declaration{"MacCyrillic", "MacCyrillic", XML}, true},
+		{"css", `@charset "windows-1251"; body {}`, Declaration{"windows-1251", "windows-1251", CSS}, true},
+		{"css single quotes", `@charset 'windows-1251'; body {}`, Declaration{}, false},
+		{"PEP 263", "#!/usr/bin/python\n# -*- coding: latin-1 -*-\n", Declaration{"windows-1252", "latin-1", Cookie}, true},
+		{"PEP 263 on third line", "#!/usr/bin/python\n\n# -*- coding: latin-1 -*-\n", Declaration{}, false},
+		{"PEP 263 after code", "import os\n# coding=latin-1\n", Declaration{}, false},
+		{"Ruby", "# encoding: euc_jp\n", Declaration{"euc-jp", "euc_jp", Cookie}, true},
+		{"Emacs", "/* -*- mode: c; coding: utf-8-unix -*- */\n", Declaration{"utf-8", "utf-8", Emacs}, true},
+		{"Vim", "<?php\n\n\n// vim: set ts=4 fileencoding=koi8-r :\n", Declaration{"koi8-r", "koi8-r", Vim}, true},
+		{"Vim short", "/* vi:fenc=gbk */", Declaration{"gbk", "gbk", Vim}, true},
+		{"Vim too late", "\n\n\n\n\n// vim: set fenc=koi8-r :\n", Declaration{}, false},
+		{"none", `<html><body>plain</body></html>`, Declaration{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := Sniff([]byte(tt.doc))
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%s: Sniff() = %+v, %v, want %+v, %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestExtractors(t *testing.T) {
+	tests := []struct {
+		name    string
+		extract Extractor
+		doc     string
+		want    Declaration
+		ok      bool
+	}{
+		{"RTF", RTFCodePage, `{\rtf1\ansi\ansicpg1251\deff0{\fonttbl{\f0 Arial;}}`, Declaration{"windows-1251", `\ansicpg1251`, RTF}, true},
+		{"RTF Japanese", RTFCodePage, `{\rtf1\ansi\ansicpg932\deff0`, Declaration{"shift_jis", `\ansicpg932`, RTF}, true},
+		{"RTF Mac", RTFCodePage, `{\rtf1\mac\deff0`, Declaration{"macintosh", `\mac`, RTF}, true},
+		{"RTF without code page", RTFCodePage, `{\rtf1\ansi\deff0`, Declaration{}, false},
+		{"not RTF", RTFCodePage, `\ansicpg1251`, Declaration{}, false},
+
+		{"PO", POHeader, "# Russian translation.\n#\nmsgid \"\"\nmsgstr \"\"\n\"Project-Id-Version: app 1.0\\n\"\n\"Content-Type: text/plain; charset=KOI8-R\\n\"\n", Declaration{"koi8-r", "KOI8-R", PO}, true},
+		{"PO template", POHeader, "msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=CHARSET\\n\"\n", Declaration{}, false},
+		{"PO without header", POHeader, "msgid \"Hello\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=KOI8-R\\n\"\n", Declaration{}, false},
+
+		{"SQL", SQLSetNames, "-- MySQL dump 10.13\n/*!40101 SET NAMES utf8mb4 */;\n", Declaration{"utf-8", "utf8mb4", SQL}, true},
+		{"SQL latin1", SQLSetNames, "SET NAMES latin1;\n", Declaration{"windows-1252", "latin1", SQL}, true},
+		{"SQL binary", SQLSetNames, "SET NAMES binary;\n", Declaration{}, false},
+		{"SQL in text", SQLSetNames, "Run SET NAMES cp1251 first.\n", Declaration{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.extract([]byte(tt.doc))
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%s: got %+v, %v, want %+v, %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestSniffWith(t *testing.T) {
+	doc := []byte("<?xml version=\"1.0\" encoding=\"koi8-r\"?>")
+	custom := func(head []byte) (Declaration, bool) {
+		return New("gbk", "custom"), true
+	}
+
+	if got, _ := SniffWith(doc, []Extractor{custom, XMLDeclaration}); got.Charset != "gbk" {
+		t.Errorf("SniffWith(custom first) = %+v, want gbk", got)
+	}
+	if got, _ := SniffWith(doc, []Extractor{XMLDeclaration, custom}); got.Charset != "koi8-r" {
+		t.Errorf("SniffWith(XML first) = %+v, want koi8-r", got)
+	}
+	if _, ok := SniffWith(doc, nil); ok {
+		t.Error("SniffWith(no extractors) found a declaration")
+	}
+}
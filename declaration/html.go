@@ -0,0 +1,273 @@
+package declaration
+
+import (
+	"bytes"
+	"strings"
+)
+
+// HTMLMeta extracts the encoding of the first usable <meta> element in head,
+// as the WHATWG "prescan a byte stream to determine its encoding" algorithm
+// does.
+func HTMLMeta(head []byte) (Declaration, bool) {
+	if label, ok := prescan(head); ok {
+		return New(label, HTML), true
+	}
+	return Declaration{}, false
+}
+
+func prescan(b []byte) (string, bool) {
+	s := &scanner{b: b}
+	for s.pos < len(b) {
+		switch {
+		case s.hasPrefix("<!--"):
+			// the two dashes of "-->" may be the ones that opened the comment
+			end := bytes.Index(b[s.pos+2:], []byte("-->"))
+			if end < 0 {
+				return "", false
+			}
+			s.pos += 2 + end + 2
+		case s.hasPrefixFold("<meta") && s.pos+5 < len(b) && (isSpace(b[s.pos+5]) || b[s.pos+5] == '/'):
+			s.pos += 5
+			if label, ok, eof := s.meta(); eof {
+				return "", false
+			} else if ok {
+				return label, true
+			}
+		case s.hasPrefix("<") && s.pos+1 < len(b) && isLetter(b[s.pos+1]),
+			s.hasPrefix("</") && s.pos+2 < len(b) && isLetter(b[s.pos+2]):
+			// skip the tag name, then its attributes
+			for s.pos < len(b) && !isSpace(b[s.pos]) && b[s.pos] != '>' {
+				s.pos++
+			}
+			for {
+				_, _, ok, eof := s.attribute()
+				if eof {
+					return "", false
+				}
+				if !ok {
+					break
+				}
+			}
+		case s.hasPrefix("<!"), s.hasPrefix("</"), s.hasPrefix("<?"):
+			end := bytes.IndexByte(b[s.pos:], '>')
+			if end < 0 {
+				return "", false
+			}
+			s.pos += end
+		}
+		s.pos++
+	}
+	return "", false
+}
+
+type scanner struct {
+	b   []byte
+	pos int
+}
+
+func (s *scanner) hasPrefix(prefix string) bool {
+	return bytes.HasPrefix(s.b[s.pos:], []byte(prefix))
+}
+
+func (s *scanner) hasPrefixFold(prefix string) bool {
+	return len(s.b)-s.pos >= len(prefix) && strings.EqualFold(string(s.b[s.pos:s.pos+len(prefix)]), prefix)
+}
+
+// meta processes the attributes of a <meta> element. It reports the declared
+// label if the element declares a usable encoding, and whether the input ran
+// out first.
+func (s *scanner) meta() (label string, ok, eof bool) {
+	const (
+		pragmaUnknown = iota
+		pragmaNeeded
+		pragmaNotNeeded
+	)
+
+	var (
+		seen      = make(map[string]bool)
+		gotPragma bool
+		need      = pragmaUnknown
+		charset   string
+	)
+
+	for {
+		name, value, ok, eof := s.attribute()
+		if eof {
+			return "", false, true
+		}
+		if !ok {
+			break
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		switch name {
+		case "http-equiv":
+			if value == "content-type" {
+				gotPragma = true
+			}
+		case "content":
+			if charset == "" {
+				if c, found := charsetFromContent(value); found {
+					charset = c
+					need = pragmaNeeded
+				}
+			}
+		case "charset":
+			charset = value
+			need = pragmaNotNeeded
+		}
+	}
+
+	if need == pragmaUnknown || (need == pragmaNeeded && !gotPragma) || charset == "" {
+		return "", false, false
+	}
+
+	name, found := Normalize(charset)
+	if !found {
+		return "", false, false
+	}
+	switch name {
+	case "utf-16be", "utf-16le":
+		// a document that can be prescanned is not UTF-16
+		return "utf-8", true, false
+	case "x-user-defined":
+		return "windows-1252", true, false
+	}
+	return charset, true, false
+}
+
+// attribute implements the WHATWG "get an attribute" algorithm. Names and
+// values are lowercased. ok is false when the tag ends, eof is true when the
+// input ends in the middle of an attribute.
+func (s *scanner) attribute() (name, value string, ok, eof bool) {
+	b := s.b
+	for s.pos < len(b) && (isSpace(b[s.pos]) || b[s.pos] == '/') {
+		s.pos++
+	}
+	if s.pos >= len(b) {
+		return "", "", false, true
+	}
+	if b[s.pos] == '>' {
+		return "", "", false, false
+	}
+
+	var n, v []byte
+	for {
+		if s.pos >= len(b) {
+			return "", "", false, true
+		}
+		c := b[s.pos]
+		if c == '=' && len(n) > 0 {
+			s.pos++
+			break
+		}
+		if isSpace(c) {
+			for s.pos < len(b) && isSpace(b[s.pos]) {
+				s.pos++
+			}
+			if s.pos >= len(b) {
+				return "", "", false, true
+			}
+			if b[s.pos] != '=' {
+				return string(n), "", true, false
+			}
+			s.pos++
+			break
+		}
+		if c == '/' || c == '>' {
+			return string(n), "", true, false
+		}
+		n = append(n, toLower(c))
+		s.pos++
+	}
+
+	for s.pos < len(b) && isSpace(b[s.pos]) {
+		s.pos++
+	}
+	if s.pos >= len(b) {
+		return "", "", false, true
+	}
+
+	switch quote := b[s.pos]; quote {
+	case '"', '\'':
+		s.pos++
+		for {
+			if s.pos >= len(b) {
+				return "", "", false, true
+			}
+			if b[s.pos] == quote {
+				s.pos++
+				return string(n), string(v), true, false
+			}
+			v = append(v, toLower(b[s.pos]))
+			s.pos++
+		}
+	case '>':
+		return string(n), "", true, false
+	}
+
+	for {
+		if s.pos >= len(b) {
+			return "", "", false, true
+		}
+		c := b[s.pos]
+		if isSpace(c) || c == '>' {
+			return string(n), string(v), true, false
+		}
+		v = append(v, toLower(c))
+		s.pos++
+	}
+}
+
+// charsetFromContent implements the WHATWG "extract a character encoding from
+// a meta element" algorithm on the value of a content attribute, as in
+// "text/html; charset=utf-8".
+func charsetFromContent(s string) (string, bool) {
+	pos := 0
+	for {
+		i := strings.Index(strings.ToLower(s[pos:]), "charset")
+		if i < 0 {
+			return "", false
+		}
+		pos += i + len("charset")
+
+		for pos < len(s) && isSpace(s[pos]) {
+			pos++
+		}
+		if pos < len(s) && s[pos] == '=' {
+			pos++
+			break
+		}
+	}
+
+	for pos < len(s) && isSpace(s[pos]) {
+		pos++
+	}
+	if pos >= len(s) {
+		return "", false
+	}
+
+	if quote := s[pos]; quote == '"' || quote == '\'' {
+		end := strings.IndexByte(s[pos+1:], quote)
+		if end < 0 {
+			return "", false
+		}
+		return s[pos+1 : pos+1+end], true
+	}
+
+	end := pos
+	for end < len(s) && !isSpace(s[end]) && s[end] != ';' {
+		end++
+	}
+	if end == pos {
+		return "", false
+	}
+	return s[pos:end], true
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,51 @@
+package declaration
+
+import (
+	"bytes"
+	"strings"
+)
+
+// POHeader extracts the charset of the Content-Type field in the header entry
+// of a gettext PO file, the entry with an empty msgid that comes first:
+//
+//	msgid ""
+//	msgstr ""
+//	"Content-Type: text/plain; charset=UTF-8\n"
+func POHeader(head []byte) (Declaration, bool) {
+	lines := bytes.Split(head, []byte("\n"))
+
+	// skip the comments before the header entry
+	i := 0
+	for i < len(lines) && isPOComment(lines[i]) {
+		i++
+	}
+	if i+1 >= len(lines) || string(bytes.TrimSpace(lines[i])) != `msgid ""` ||
+		string(bytes.TrimSpace(lines[i+1])) != `msgstr ""` {
+		return Declaration{}, false
+	}
+
+	for _, line := range lines[i+2:] {
+		line = bytes.TrimSpace(line)
+		if len(line) < 2 || line[0] != '"' || line[len(line)-1] != '"' {
+			break
+		}
+
+		field, found := strings.CutPrefix(string(line[1:len(line)-1]), "Content-Type:")
+		if !found {
+			continue
+		}
+		field, _, _ = strings.Cut(field, `\n`)
+		label, ok := charsetFromContent(field)
+		// "CHARSET" is the placeholder of a template nobody filled in
+		if !ok || label == "CHARSET" {
+			return Declaration{}, false
+		}
+		return New(label, PO), true
+	}
+	return Declaration{}, false
+}
+
+func isPOComment(line []byte) bool {
+	line = bytes.TrimSpace(line)
+	return len(line) == 0 || line[0] == '#'
+}
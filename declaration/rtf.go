@@ -0,0 +1,79 @@
+package declaration
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// RTFCodePage extracts the ANSI code page of an RTF document from the
+// \ansicpg control word of its header, as in {\rtf1\ansi\ansicpg1251, or the
+// \mac character set.
+func RTFCodePage(head []byte) (Declaration, bool) {
+	if !bytes.HasPrefix(head, []byte(`{\rtf`)) {
+		return Declaration{}, false
+	}
+
+	if i := bytes.Index(head, []byte(`\ansicpg`)); i >= 0 {
+		word := head[i:]
+		n := len(`\ansicpg`)
+		for n < len(word) && word[n] >= '0' && word[n] <= '9' {
+			n++
+		}
+		cp, err := strconv.Atoi(string(word[len(`\ansicpg`):n]))
+		if err != nil || cp == 0 {
+			return Declaration{}, false
+		}
+		decl := New(codePageLabel(cp), RTF)
+		decl.Label = string(word[:n])
+		return decl, true
+	}
+
+	if i := bytes.Index(head, []byte(`\mac`)); i >= 0 && (i+4 == len(head) || !isLetter(head[i+4])) {
+		decl := New("macintosh", RTF)
+		decl.Label = `\mac`
+		return decl, true
+	}
+	return Declaration{}, false
+}
+
+// codePageLabel returns an encoding label for a Windows code page number.
+func codePageLabel(cp int) string {
+	switch {
+	case cp == 874 || (cp >= 1250 && cp <= 1258):
+		return "windows-" + strconv.Itoa(cp)
+	case cp >= 28591 && cp <= 28599:
+		return "iso-8859-" + strconv.Itoa(cp-28590)
+	}
+
+	switch cp {
+	case 866:
+		return "ibm866"
+	case 932:
+		return "shift_jis"
+	case 936:
+		return "gbk"
+	case 949:
+		return "euc-kr"
+	case 950:
+		return "big5"
+	case 10000:
+		return "macintosh"
+	case 10007:
+		return "x-mac-cyrillic"
+	case 20866:
+		return "koi8-r"
+	case 21866:
+		return "koi8-u"
+	case 20932, 51932:
+		return "euc-jp"
+	case 28603:
+		return "iso-8859-13"
+	case 28605:
+		return "iso-8859-15"
+	case 54936:
+		return "gb18030"
+	case 65001:
+		return "utf-8"
+	}
+	return "cp" + strconv.Itoa(cp)
+}
@@ -0,0 +1,63 @@
+package declaration
+
+import (
+	"regexp"
+	"strings"
+)
+
+// setNames matches the SET NAMES statement of a MySQL dump, which may be
+// wrapped in a version comment as in /*!40101 SET NAMES utf8mb4 */;
+var setNames = regexp.MustCompile(`(?im)^[ \t]*(?:/\*!\d+[ \t]+)?SET[ \t]+NAMES[ \t]+['"]?(\w+)`)
+
+// mysqlCharsets maps MySQL character set names to encoding labels.
+var mysqlCharsets = map[string]string{
+	"ascii":   "us-ascii",
+	"big5":    "big5",
+	"cp1250":  "windows-1250",
+	"cp1251":  "windows-1251",
+	"cp1256":  "windows-1256",
+	"cp1257":  "windows-1257",
+	"cp866":   "ibm866",
+	"cp932":   "shift_jis",
+	"euckr":   "euc-kr",
+	"eucjpms": "euc-jp",
+	"gb18030": "gb18030",
+	"gb2312":  "gb2312",
+	"gbk":     "gbk",
+	"greek":   "iso-8859-7",
+	"hebrew":  "iso-8859-8",
+	"koi8r":   "koi8-r",
+	"koi8u":   "koi8-u",
+	// MySQL's latin1 is Windows-1252
+	"latin1":   "windows-1252",
+	"latin2":   "iso-8859-2",
+	"latin5":   "iso-8859-9",
+	"latin7":   "iso-8859-13",
+	"macce":    "x-mac-ce",
+	"macroman": "macintosh",
+	"sjis":     "shift_jis",
+	"tis620":   "tis-620",
+	"ujis":     "euc-jp",
+	"utf8":     "utf-8",
+	"utf8mb3":  "utf-8",
+	"utf8mb4":  "utf-8",
+}
+
+// SQLSetNames extracts the connection character set of a MySQL dump from its
+// SET NAMES statement.
+func SQLSetNames(head []byte) (Declaration, bool) {
+	m := setNames.FindSubmatch(head)
+	if m == nil {
+		return Declaration{}, false
+	}
+
+	name := strings.ToLower(string(m[1]))
+	label, ok := mysqlCharsets[name]
+	if !ok {
+		// binary and the UCS character sets say nothing about the dump
+		return Declaration{}, false
+	}
+	decl := New(label, SQL)
+	decl.Label = string(m[1])
+	return decl, true
+}
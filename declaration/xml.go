@@ -0,0 +1,47 @@
+package declaration
+
+import (
+	"bytes"
+)
+
+// XMLDeclaration extracts the encoding pseudo-attribute of the XML
+// declaration that opens head, as in
+// <?xml version="1.0" encoding="Shift_JIS"?>.
+func XMLDeclaration(head []byte) (Declaration, bool) {
+	if label, ok := xmlEncoding(head); ok {
+		return New(label, XML), true
+	}
+	return Declaration{}, false
+}
+
+func xmlEncoding(b []byte) (string, bool) {
+	end := bytes.Index(b, []byte("?>"))
+	if !bytes.HasPrefix(b, []byte("<?xml")) || end < 0 || len(b) < 6 || !isSpace(b[5]) {
+		return "", false
+	}
+	decl := b[5:end]
+
+	for {
+		i := bytes.Index(decl, []byte("encoding"))
+		if i < 0 {
+			return "", false
+		}
+		decl = decl[i+len("encoding"):]
+
+		rest := bytes.TrimLeft(decl, " \t\r\n")
+		if len(rest) == 0 || rest[0] != '=' {
+			continue
+		}
+		rest = bytes.TrimLeft(rest[1:], " \t\r\n")
+		if len(rest) == 0 || (rest[0] != '"' && rest[0] != '\'') {
+			return "", false
+		}
+
+		value := rest[1:]
+		closing := bytes.IndexByte(value, rest[0])
+		if closing <= 0 {
+			return "", false
+		}
+		return string(value[:closing]), true
+	}
+}
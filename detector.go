@@ -2,8 +2,11 @@ package chardet
 
 import (
 	"bytes"
+	"log/slog"
+	"strings"
 
 	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/declaration"
 	"github.com/wlynxg/chardet/probe"
 )
 
@@ -17,14 +20,83 @@ type Result struct {
 	Confidence float64 `json:"confidence,omitempty"`
 	// Language represents the detected language (if applicable)
 	Language string `json:"language,omitempty"`
+	// Visual reports that right-to-left text is stored in visual order, left to
+	// right as it is displayed, so lines must be reversed before rendering
+	Visual bool `json:"visual,omitempty"`
+	// Source tells whether the result came from a byte order mark, from an
+	// encoding declared in the document or from statistics
+	Source Source `json:"source,omitempty"`
+	// Declared is the encoding the document declares about itself, if any
+	Declared string `json:"declared,omitempty"`
+	// Conflict reports that the declared encoding disagrees with the result
+	Conflict bool `json:"conflict,omitempty"`
+	// Binary reports that the input is not text, in which case Encoding and
+	// Charset are empty
+	Binary bool `json:"binary,omitempty"`
+	// Reason tells why the input was classified as binary
+	Reason string `json:"reason,omitempty"`
+	// Container is the compression format the text was decompressed from
+	Container Container `json:"container,omitempty"`
+	// Wrapping is the transfer encoding the text was unwrapped from
+	Wrapping Wrapping `json:"wrapping,omitempty"`
 }
 
+// Source tells where a detection result came from.
+type Source string
+
+const (
+	// SourceBOM is a byte order mark, which is always trusted
+	SourceBOM Source = "bom"
+	// SourceDeclaration is an encoding the document declares, such as an
+	// HTML <meta> charset or a coding cookie, used when the statistics give
+	// no answer or too weak a one to rule it out
+	SourceDeclaration Source = "declaration"
+	// SourceStatistics is the probes' analysis of the bytes
+	SourceStatistics Source = "statistics"
+	// SourceJSON is the pattern of zero bytes a JSON text starts with
+	SourceJSON Source = "json"
+)
+
+// declarationConfidence is the confidence of a result taken from a declaration
+// that the statistics could neither confirm nor contradict.
+const declarationConfidence = 0.5
+
 // UniversalDetector implements universal character encoding detection
 type UniversalDetector struct {
 	// MinimumThreshold is the minimum confidence threshold for detection
 	MinimumThreshold float64
 	// IsoWinMap maps ISO encodings to Windows encodings
 	IsoWinMap map[string]string
+	// Extractors find the encoding declared by the document, tried in order
+	Extractors []declaration.Extractor
+	// PreDecode unwraps quoted-printable, percent-encoded and numeric entity
+	// text that is otherwise ASCII and detects the encoding of what it wraps
+	PreDecode bool
+	// KeepTerminalEscapes feeds the CSI and OSC escape sequences of coloured
+	// terminal output to the probes instead of removing them, so that they
+	// count as ISO-2022 escapes
+	KeepTerminalEscapes bool
+	// Charsets restricts the results to the listed charsets, when not empty.
+	// Names are compared ignoring case, hyphens and underscores, and the
+	// probes of other charsets are not run
+	Charsets []string
+	// ExcludeCharsets lists charsets that are never reported
+	ExcludeCharsets []string
+	// Hints are the caller's expectations about the encoding, weighed against
+	// the probes when none of them is sure
+	Hints []Hint
+	// Tuning overrides the thresholds of the probes, which are created on
+	// demand by Feed
+	Tuning probe.Tuning
+	// Logger receives debug events: the changes of input state, the verdicts
+	// of the probes, the renaming of ISO-8859 charsets when Windows bytes
+	// were seen and the result. Like Tuning, it applies to the probes Feed
+	// creates after it is set
+	Logger *slog.Logger
+	// Observer is told when probes are ruled out or find their charset, when
+	// the input state changes and when detection ends. Like Logger, it
+	// applies to the probes Feed creates after it is set
+	Observer Observer
 
 	// done indicates if detection is complete
 	done bool
@@ -32,9 +104,24 @@ type UniversalDetector struct {
 	gotData bool
 	// hasWinBytes indicates if Windows-specific bytes were detected
 	hasWinBytes bool
+	// hasNonISO88598Bytes indicates if bytes ISO-8859-8 leaves undefined
+	// were detected
+	hasNonISO88598Bytes bool
+
+	// fed and textFed count the bytes fed, before and after the removal of
+	// terminal escapes, to place the verdicts of the probes in the input
+	fed, textFed int
 
 	// lastChars stores the last processed characters
 	lastChars []byte
+	// head stores the start of the input, where encodings are declared
+	head []byte
+	// ascii stores the input while it is pure ASCII, for PreDecode
+	ascii []byte
+	// ansi removes terminal escape sequences unless KeepTerminalEscapes is set
+	ansi ansiStripper
+	// checked indicates if the result was cross-checked against the declaration
+	checked bool
 	// inputState tracks the current input processing state
 	inputState consts.InputState
 	// filter specifies which languages to detect
@@ -43,7 +130,11 @@ type UniversalDetector struct {
 	// Various probes used for detection
 	escCharsetProbe probe.Probe
 	utf1632Probe    *probe.UTF1632Probe
+	utf16BlockProbe *probe.UTF16BlockProbe
+	binaryProbe     *probe.BinaryProbe
 	charsetProbes   []probe.Probe
+	// probeSettings are the settings the charset probes were created with
+	probeSettings probeSettings
 
 	// result stores the final detection result
 	result Result
@@ -67,6 +158,7 @@ func NewUniversalDetector(filter consts.LangFilter) *UniversalDetector {
 			consts.ISO88599:  consts.Windows1254,
 			consts.ISO885913: consts.Windows1257,
 		},
+		Extractors: declaration.DefaultExtractors(),
 
 		inputState: consts.PureAsciiInputState,
 		lastChars:  []byte{},
@@ -80,8 +172,14 @@ func (u *UniversalDetector) Reset() {
 	u.done = false
 	u.gotData = false
 	u.hasWinBytes = false
+	u.hasNonISO88598Bytes = false
+	u.fed, u.textFed = 0, 0
 	u.inputState = consts.PureAsciiInputState
 	u.lastChars = []byte{}
+	u.head = nil
+	u.ascii = nil
+	u.ansi.reset()
+	u.checked = false
 
 	if u.escCharsetProbe != nil {
 		u.escCharsetProbe.Reset()
@@ -91,6 +189,14 @@ func (u *UniversalDetector) Reset() {
 		u.utf1632Probe.Reset()
 	}
 
+	if u.utf16BlockProbe != nil {
+		u.utf16BlockProbe.Reset()
+	}
+
+	if u.binaryProbe != nil {
+		u.binaryProbe.Reset()
+	}
+
 	for _, p := range u.charsetProbes {
 		if p != nil {
 			p.Reset()
@@ -104,6 +210,16 @@ func (u *UniversalDetector) Feed(buf []byte) bool {
 	if u.done || len(buf) == 0 {
 		return false
 	}
+	if u.Observer != nil {
+		defer u.notifyDone()
+	}
+
+	offset := u.fed
+	u.fed += len(buf)
+
+	if len(u.head) < declaration.PrescanLength {
+		u.head = append(u.head, buf[:min(len(buf), declaration.PrescanLength-len(u.head))]...)
+	}
 
 	// First check for known BOMs, since these are guaranteed to be correct
 	if !u.gotData {
@@ -127,38 +243,87 @@ func (u *UniversalDetector) Feed(buf []byte) bool {
 		}
 		u.gotData = true
 		if encoding != "" {
-			u.result = newResult(encoding, 1.0, "")
-			u.done = true
+			res := newResult(encoding, 1.0, "")
+			res.Source = SourceBOM
+			if !u.accept(res) {
+				// text with a BOM is in the encoding of the BOM, and never
+				// binary despite its NULs: when that encoding is ruled out
+				// there is no answer
+				u.done = true
+			}
 			return false
 		}
 	}
 
-	// If none of those matched, and we've only seen ASCII so far, check
-	// for high bytes and escape sequences.
-	if u.inputState == consts.PureAsciiInputState {
-		if HighByteDetector(buf) {
-			u.inputState = consts.HighByteInputState
-		} else if u.inputState == consts.PureAsciiInputState &&
-			EscDetector(bytes.Join([][]byte{u.lastChars, buf}, nil)) {
-			u.inputState = consts.EcsAsciiInputState
-		}
+	// Binary formats are recognised by their magic number; NULs and control
+	// characters are only weighed at the end, once the UTF-16/32 probes have
+	// had their chance
+	if u.binaryProbe == nil {
+		u.binaryProbe = probe.NewBinaryProbe()
 	}
 
-	u.lastChars = append(u.lastChars, buf[len(buf)-1])
+	if u.feedProbe(u.binaryProbe, buf, offset) == consts.FoundItProbingState {
+		u.result = u.binaryResult()
+		u.done = true
+		return false
+	}
+
+	// Colours and titles of terminal output are not ISO-2022 escapes. The
+	// UTF-16/32 probes still see the raw bytes, where 0x1B may be half of a
+	// code unit, even when nothing is left of them
+	raw := buf
+	if !u.KeepTerminalEscapes {
+		buf = u.ansi.strip(buf)
+	}
 
 	// next we will look to see if it is appears to be either a UTF-16 or UTF-32 encoding
 	if u.utf1632Probe == nil {
 		u.utf1632Probe = probe.NewUTF1632Probe()
+		probe.Tune(u.utf1632Probe, u.Tuning)
 	}
 
 	if u.utf1632Probe.State() == consts.DetectingProbingState {
-		if u.utf1632Probe.Feed(buf) == consts.FoundItProbingState {
-			u.result = newResult(u.utf1632Probe.CharSetName(), u.utf1632Probe.GetConfidence(), "")
-			u.done = true
+		if u.feedProbe(u.utf1632Probe, raw, offset) == consts.FoundItProbingState &&
+			u.accept(newResult(u.utf1632Probe.CharSetName(), u.utf1632Probe.GetConfidence(), "")) {
+			return false
+		}
+	}
+
+	// UTF-16 text in non-Latin scripts has few zero bytes, so check the
+	// distribution of code units over Unicode blocks as well
+	if u.utf16BlockProbe == nil {
+		u.utf16BlockProbe = probe.NewUTF16BlockProbe()
+	}
+
+	if u.utf16BlockProbe.State() == consts.DetectingProbingState {
+		if u.feedProbe(u.utf16BlockProbe, raw, offset) == consts.FoundItProbingState &&
+			u.accept(newResult(u.utf16BlockProbe.CharSetName(), u.utf16BlockProbe.GetConfidence(), "")) {
 			return false
 		}
 	}
 
+	if len(buf) == 0 {
+		return true
+	}
+	textOffset := u.textFed
+	u.textFed += len(buf)
+
+	// If none of those matched, and we've only seen ASCII so far, check
+	// for high bytes and escape sequences. High bytes after escapes rule out
+	// the 7-bit ISO-2022 and HZ encodings the escapes may have started.
+	if u.inputState != consts.HighByteInputState && HighByteDetector(buf) {
+		u.setInputState(consts.HighByteInputState, textOffset)
+	} else if u.inputState == consts.PureAsciiInputState &&
+		EscDetector(bytes.Join([][]byte{u.lastChars, buf}, nil)) {
+		u.setInputState(consts.EcsAsciiInputState, textOffset)
+	}
+
+	if u.PreDecode && u.inputState == consts.PureAsciiInputState && len(u.ascii) < maxPreDecode {
+		u.ascii = append(u.ascii, buf[:min(len(buf), maxPreDecode-len(u.ascii))]...)
+	}
+
+	u.lastChars = append(u.lastChars, buf[len(buf)-1])
+
 	switch u.inputState {
 	case consts.EcsAsciiInputState:
 		// If we've seen escape sequences, use the EscCharSetProbe, which
@@ -167,11 +332,12 @@ func (u *UniversalDetector) Feed(buf []byte) bool {
 		// use such sequences.
 		if u.escCharsetProbe == nil {
 			u.escCharsetProbe = probe.NewEscCharSetProbe(u.filter)
+			probe.SetLogger(u.escCharsetProbe, u.Logger)
+			probe.SetObserver(u.escCharsetProbe, u.Observer)
 		}
 
-		if u.escCharsetProbe.Feed(buf) == consts.FoundItProbingState {
-			u.result = newResult(u.escCharsetProbe.CharSetName(), u.escCharsetProbe.GetConfidence(), u.escCharsetProbe.Language())
-			u.done = true
+		if u.feedProbe(u.escCharsetProbe, buf, textOffset) == consts.FoundItProbingState {
+			u.accept(newResult(u.escCharsetProbe.CharSetName(), u.escCharsetProbe.GetConfidence(), u.escCharsetProbe.Language()))
 		}
 	case consts.HighByteInputState:
 		// If we've seen high bytes (i.e., those with values greater than 127),
@@ -181,12 +347,8 @@ func (u *UniversalDetector) Feed(buf []byte) bool {
 		// the multi-byte probes use a combination of character unigram and
 		// bigram distributions.
 		if len(u.charsetProbes) == 0 {
-			u.charsetProbes = []probe.Probe{probe.MBCGroupProbe(u.filter)}
-			// If we're checking non-CJK encodings, use single-byte probe
-			if u.filter&consts.NonCjkLangFilter != 0 {
-				u.charsetProbes = append(u.charsetProbes, probe.NewSBCSGroupProbe())
-			}
-			u.charsetProbes = append(u.charsetProbes, probe.NewLatin1Probe(), probe.NewMacRomanProbe())
+			u.probeSettings = u.currentProbeSettings()
+			u.charsetProbes = u.newCharsetProbes()
 		}
 
 		for _, charsetProbe := range u.charsetProbes {
@@ -194,9 +356,8 @@ func (u *UniversalDetector) Feed(buf []byte) bool {
 				continue
 			}
 
-			if charsetProbe.Feed(buf) == consts.FoundItProbingState {
-				u.result = newResult(charsetProbe.CharSetName(), charsetProbe.GetConfidence(), charsetProbe.Language())
-				u.done = true
+			if u.feedProbe(charsetProbe, buf, textOffset) == consts.FoundItProbingState &&
+				u.accept(newResult(charsetProbe.CharSetName(), charsetProbe.GetConfidence(), charsetProbe.Language())) {
 				break
 			}
 		}
@@ -204,6 +365,9 @@ func (u *UniversalDetector) Feed(buf []byte) bool {
 		if WinByteDetector(buf) {
 			u.hasWinBytes = true
 		}
+		if nonISO88598ByteDetector(buf) {
+			u.hasNonISO88598Bytes = true
+		}
 	default:
 	}
 	return !u.done
@@ -212,27 +376,132 @@ func (u *UniversalDetector) Feed(buf []byte) bool {
 // GetResult returns the final character encoding detection result
 // If detection is not complete, it will finalize the detection process
 func (u *UniversalDetector) GetResult() Result {
+	if !u.done {
+		u.done = true
+		u.finalize()
+		if u.Observer != nil {
+			defer u.notifyDone()
+		}
+	}
+
+	if !u.checked {
+		u.checked = true
+		u.checkDeclaration()
+		if u.Logger != nil {
+			u.Logger.Debug("detection done", "charset", u.result.Charset, "language", u.result.Language,
+				"confidence", u.result.Confidence, "source", u.result.Source, "binary", u.result.Binary)
+		}
+	}
+	return u.result
+}
+
+// feedProbe feeds p with buf, which starts offset bytes into the input, and
+// logs the verdict p reaches and tells the observer about it.
+func (u *UniversalDetector) feedProbe(p probe.Probe, buf []byte, offset int) consts.ProbingState {
+	probe.SetOffset(p, offset)
+	if u.Logger == nil && u.Observer == nil {
+		return p.Feed(buf)
+	}
+
+	s, ok := p.(interface{ State() consts.ProbingState })
+	detecting := ok && s.State() == consts.DetectingProbingState
+	state := p.Feed(buf)
+	if detecting && state != consts.DetectingProbingState {
+		probe.LogVerdict(u.Logger, p)
+		if u.Observer != nil {
+			u.Observer.OnProbeStateChange(probe.StateChangeOf(p, ""))
+		}
+	}
+	return state
+}
+
+// notifyDone tells the observer the result, once detection has ended.
+func (u *UniversalDetector) notifyDone() {
 	if u.done {
-		return u.result
+		u.Observer.OnDone(u.Peek())
+	}
+}
+
+// setInputState moves to state on the buffer fed at offset.
+func (u *UniversalDetector) setInputState(state consts.InputState, offset int) {
+	if u.Logger != nil {
+		u.Logger.Debug("input state changed", "from", inputStateName(u.inputState), "to", inputStateName(state), "offset", offset)
+	}
+	if u.Observer != nil {
+		u.Observer.OnInputStateChange(u.inputState, state)
+	}
+	u.inputState = state
+}
+
+func inputStateName(state consts.InputState) string {
+	switch state {
+	case consts.EcsAsciiInputState:
+		return "escapes"
+	case consts.HighByteInputState:
+		return "high bytes"
+	default:
+		return "ASCII"
 	}
-	u.done = true
+}
 
+// Peek returns the best guess for the data fed so far without ending
+// detection, so that more data can still be fed and the guess refined. Once
+// detection is done, it returns the final result.
+func (u *UniversalDetector) Peek() Result {
+	result, checked := u.result, u.checked
+	if !u.done {
+		u.finalize()
+	}
+	if !u.checked {
+		u.checkDeclaration()
+	}
+
+	peeked := u.result
+	u.result, u.checked = result, checked
+	return peeked
+}
+
+// PeekAll returns the candidates for the data fed so far, the most confident
+// first, as DetectAll does, without ending detection.
+func (u *UniversalDetector) PeekAll() []Result {
+	return u.allResults()
+}
+
+// finalize picks the result of the most confident probe.
+func (u *UniversalDetector) finalize() {
 	switch {
 	case !u.gotData:
+	case u.binaryProbe != nil && u.isBinary():
+		u.result = u.binaryResult()
 	case u.inputState == consts.PureAsciiInputState:
-		u.result = newResult(consts.Ascii, 1.0, "")
+		u.result = u.asciiResult()
+		if u.PreDecode {
+			u.preDecode()
+		}
+	case u.inputState == consts.EcsAsciiInputState:
+		// escapes that never formed ISO-2022 or HZ text, as the ~{ of
+		// templates, leave plain 7-bit text, as high bytes would have moved
+		// on to the charset probes
+		u.result = u.asciiResult()
 	case u.inputState == consts.HighByteInputState:
 		var (
 			confidence, maxProbeConfidence float64
 			maxConfidenceProbe             probe.Probe
 		)
 
-		for _, charsetProbe := range u.charsetProbes {
+		// hints and restrictions may favour a member of a group other than
+		// the one the group names
+		probes := u.charsetProbes
+		if u.restricted() || len(u.Hints) > 0 {
+			probes = u.permittedProbes()
+		}
+
+		for _, charsetProbe := range probes {
 			if charsetProbe == nil {
 				continue
 			}
 
-			confidence = charsetProbe.GetConfidence()
+			confidence = u.weigh(charsetProbe).Confidence
 			if confidence > maxProbeConfidence {
 				maxProbeConfidence = confidence
 				maxConfidenceProbe = charsetProbe
@@ -240,20 +509,228 @@ func (u *UniversalDetector) GetResult() Result {
 		}
 
 		if maxConfidenceProbe != nil && maxProbeConfidence > u.MinimumThreshold {
-			charsetName := maxConfidenceProbe.CharSetName()
-			confidence := maxConfidenceProbe.GetConfidence()
-
-			if u.hasWinBytes {
-				// Use Windows encoding name instead of ISO-8859 if we saw any
-				// extra Windows-specific bytes
-				if n, ok := u.IsoWinMap[maxConfidenceProbe.CharSetName()]; ok {
-					charsetName = n
-				}
+			u.result = u.weigh(maxConfidenceProbe)
+			if name := maxConfidenceProbe.CharSetName(); u.Logger != nil && u.hasWinBytes && u.IsoWinMap[name] != "" {
+				u.Logger.Debug("charset renamed for Windows bytes", "from", name, "to", u.IsoWinMap[name])
 			}
-			u.result = newResult(charsetName, confidence, maxConfidenceProbe.Language())
 		}
 	}
-	return u.result
+}
+
+// preDecode replaces the ASCII result with the encoding of the text wrapped in
+// the ASCII input, if any.
+func (u *UniversalDetector) preDecode() {
+	text, wrapping := unwrap(u.ascii)
+	if wrapping == "" {
+		return
+	}
+
+	d := NewUniversalDetector(u.filter)
+	d.MinimumThreshold = u.MinimumThreshold
+	d.IsoWinMap = u.IsoWinMap
+	d.KeepTerminalEscapes = u.KeepTerminalEscapes
+	d.Tuning = u.Tuning
+	d.Charsets = u.Charsets
+	d.ExcludeCharsets = u.ExcludeCharsets
+	d.Hints = u.Hints
+	d.Logger = u.Logger
+	d.Extractors = nil
+	d.Feed(text)
+	if res := d.GetResult(); res.Charset != "" && !res.Binary {
+		res.Wrapping = wrapping
+		u.result = res
+	}
+}
+
+func (u *UniversalDetector) isBinary() bool {
+	binary, _ := u.binaryProbe.Binary()
+	return binary
+}
+
+// binaryResult builds the result for input that is not text.
+func (u *UniversalDetector) binaryResult() Result {
+	_, reason := u.binaryProbe.Binary()
+	return Result{
+		Confidence: u.binaryProbe.GetConfidence(),
+		Source:     SourceStatistics,
+		Binary:     true,
+		Reason:     reason,
+	}
+}
+
+// checkDeclaration cross-checks the result against the encoding the document
+// declares about itself. Declarations are usually, but not always, right, so
+// the statistics win when they have an answer and disagreements are flagged.
+func (u *UniversalDetector) checkDeclaration() {
+	if u.result.Binary {
+		return
+	}
+
+	decl, ok := declaration.SniffWith(u.head, u.Extractors)
+	if !ok {
+		return
+	}
+
+	switch {
+	case u.result.Charset == "":
+		res := newResult(declaredCharset(decl), declarationConfidence, "")
+		res.Source = SourceDeclaration
+		if u.permits(res) {
+			u.result = res
+		}
+	case declarationAgrees(u.result, decl.Charset):
+	case decl.Syntax.Hint() && u.preferDeclared(decl.Charset):
+	case !decl.Syntax.Hint() && u.result.Confidence < declarationTrust && u.preferDocumentDeclared(decl):
+	default:
+		u.result.Conflict = true
+	}
+	u.result.Declared = decl.Charset
+}
+
+// hintMargin is how close to the best probe's confidence a probe agreeing
+// with a coding cookie or modeline must come to be preferred.
+const hintMargin = 0.8
+
+// preferDeclared switches the result to the most confident probe that agrees
+// with a coding cookie or modeline, if it comes close enough to the best one.
+// Source files are mostly ASCII and leave the statistics little to go on,
+// while the editor that saved them followed the hint.
+func (u *UniversalDetector) preferDeclared(declared string) bool {
+	var best Result
+	for _, p := range u.leafProbes() {
+		if !p.IsActive() {
+			continue
+		}
+		if r := u.probeResult(p); r.Confidence > best.Confidence && declarationAgrees(r, declared) && u.permits(r) {
+			best = r
+		}
+	}
+
+	if best.Confidence <= u.MinimumThreshold || best.Confidence < u.result.Confidence*hintMargin {
+		return false
+	}
+	u.result = best
+	return true
+}
+
+// declarationTrust is the confidence below which the statistics give way to an
+// HTML, XML or CSS declaration they cannot rule out. Latin1Probe and
+// MacRomanProbe, the fallbacks for Latin text, never exceed 0.73.
+const declarationTrust = 0.75
+
+// preferDocumentDeclared switches a weak result to the encoding the document
+// declares, unless the statistics ruled it out: to the most confident probe
+// that agrees with it, or to the declaration itself when no probe models it.
+func (u *UniversalDetector) preferDocumentDeclared(decl declaration.Declaration) bool {
+	var best Result
+	modelled := false
+	for _, p := range u.leafProbes() {
+		r := u.probeResult(p)
+		if !declarationAgrees(r, decl.Charset) {
+			continue
+		}
+		modelled = true
+		if p.IsActive() && r.Confidence > best.Confidence && u.permits(r) {
+			best = r
+		}
+	}
+
+	switch {
+	case best.Confidence > u.MinimumThreshold:
+		u.result = best
+	case !modelled:
+		res := newResult(declaredCharset(decl), declarationConfidence, "")
+		res.Source = SourceDeclaration
+		if !u.permits(res) {
+			return false
+		}
+		u.result = res
+	default:
+		return false
+	}
+	return true
+}
+
+// leafProbes returns the charset probes with the groups replaced by their
+// members.
+func (u *UniversalDetector) leafProbes() []probe.Probe {
+	var probes []probe.Probe
+	for _, p := range u.charsetProbes {
+		switch g := p.(type) {
+		case nil:
+		case interface{ Probes() []probe.Probe }:
+			probes = append(probes, g.Probes()...)
+		default:
+			probes = append(probes, p)
+		}
+	}
+	return probes
+}
+
+// compatibleLabels names, for detected charsets the WHATWG Encoding Standard
+// has no label for, an encoding that reads the detected text the same way.
+var compatibleLabels = map[string]string{
+	consts.CP932:     "windows-31j",
+	consts.CP949:     "windows-949",
+	consts.ISO88598I: "windows-1255",
+}
+
+// declarationAgrees reports whether the detected result can be read as the
+// declared encoding.
+func declarationAgrees(result Result, declared string) bool {
+	switch {
+	case strings.EqualFold(result.Charset, declared), strings.EqualFold(result.Encoding, declared):
+		return true
+	case result.Charset == consts.CanonicalCharset(consts.Ascii):
+		// ASCII is a subset of every encoding but UTF-16
+		return !strings.HasPrefix(declared, "utf-16")
+	}
+
+	label := strings.TrimSuffix(result.Charset, "-SIG")
+	if l, ok := compatibleLabels[label]; ok {
+		label = l
+	}
+	name, ok := declaration.Normalize(label)
+	return ok && name == declared
+}
+
+// probeResult builds the result reported by a probe, taking into account the
+// bytes seen by the detector as a whole.
+func (u *UniversalDetector) probeResult(p probe.Probe) Result {
+	charsetName := p.CharSetName()
+
+	// HebrewProbe names visual Hebrew ISO-8859-8 and logical Hebrew
+	// Windows-1255
+	visual := charsetName == consts.ISO88598
+
+	if u.hasWinBytes {
+		// Use Windows encoding name instead of ISO-8859 if we saw any
+		// extra Windows-specific bytes
+		if n, ok := u.IsoWinMap[charsetName]; ok {
+			charsetName = n
+		}
+	}
+
+	result := newResult(charsetName, p.GetConfidence(), p.Language())
+	result.Visual = visual
+	if charsetName == consts.Windows1255 && !u.hasNonISO88598Bytes {
+		// logical Hebrew without any byte ISO-8859-8 leaves undefined, such
+		// as the niqqud of Windows-1255, is plain ISO-8859-8 in logical
+		// order, which IANA names ISO-8859-8-I
+		result.Charset = consts.ISO88598I
+	}
+	return result
+}
+
+// nonISO88598ByteDetector checks if the buffer contains bytes ISO-8859-8
+// leaves undefined, which Windows-1255 uses for punctuation and niqqud
+func nonISO88598ByteDetector(buf []byte) bool {
+	for _, b := range buf {
+		if b >= 0x80 && b <= 0x9F || b == 0xA1 || b >= 0xBF && b <= 0xDE || b >= 0xFB && b != 0xFD && b != 0xFE {
+			return true
+		}
+	}
+	return false
 }
 
 // HighByteDetector checks if the buffer contains any bytes with values >= 0x80
@@ -0,0 +1,492 @@
+package chardet
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/declaration"
+	"github.com/wlynxg/chardet/lookup"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func TestDetectBOMLessUTF16NonLatin(t *testing.T) {
+	texts := []string{
+		"日志记录：服务已启动，正在监听端口 8080。\r\n用户登录成功，会话已建立。\r\n",
+		"サービスを開始しました。ポート 8080 で待機しています。\r\nユーザーがログインしました。\r\n",
+		"서비스가 시작되었습니다. 포트 8080에서 대기 중입니다.\r\n사용자가 로그인했습니다.\r\n",
+		"Служба запущена и ожидает подключений на порту 8080.\r\nПользователь вошёл в систему.\r\n",
+		"تم تشغيل الخدمة وهي تنتظر الاتصالات على المنفذ 8080.\r\n",
+		// no ASCII at all
+		"日志记录服务已启动正在监听端口用户登录成功会话已建立",
+		"東京都渋谷区で新規事業を開始しました利用者登録を受付中です",
+		"서비스가시작되었습니다사용자가로그인했습니다",
+		"СлужбазапущенаиожидаетподключенийПользовательвошёлвсистему",
+	}
+
+	encodings := map[string]unicode.Endianness{
+		consts.UTF16Le: unicode.LittleEndian,
+		consts.UTF16Be: unicode.BigEndian,
+	}
+
+	for want, endianness := range encodings {
+		encoder := unicode.UTF16(endianness, unicode.IgnoreBOM).NewEncoder()
+		for _, text := range texts {
+			buf, err := encoder.Bytes([]byte(text))
+			if err != nil {
+				t.Fatalf("failed to encode %q: %v", text, err)
+			}
+
+			if res := Detect(buf); res.Encoding != want {
+				t.Errorf("Detect(%s %q) = %s, want %s", want, text, res.Encoding, want)
+			}
+		}
+	}
+}
+
+func TestDetectLegacyNotUTF16(t *testing.T) {
+	tests := map[string][]byte{
+		"UTF-8 Russian": []byte("Служба запущена и ожидает подключений на порту 8080."),
+		"UTF-8 Chinese": []byte("日志记录：服务已启动，正在监听端口。"),
+		"Shift_JIS":     []byte("\x83\x54\x81\x5b\x83\x72\x83\x58\x82\xf0\x8a\x4a\x8e\x6e\x82\xb5\x82\xdc\x82\xb5\x82\xbd\x81\x42"),
+	}
+
+	// legacy CJK text without ASCII, whose double-byte characters fall in the
+	// Hangul and CJK blocks when read as code units
+	for name, text := range map[string]string{
+		"GB2312": "日志记录服务已启动正在监听端口用户登录成功会话已建立",
+		"Big5":   "日誌記錄服務已啟動正在監聽端口用戶登錄成功會話已建立",
+		"EUC-KR": "서비스가시작되었습니다사용자가로그인했습니다",
+	} {
+		e, err := lookup.LookupEncoding(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tests[name], err = e.NewEncoder().Bytes([]byte(text)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, buf := range tests {
+		if res := Detect(buf); res.Encoding == consts.UTF16Le || res.Encoding == consts.UTF16Be {
+			t.Errorf("Detect(%s) = %s, did not expect UTF-16", name, res.Encoding)
+		}
+	}
+}
+
+func TestDetectLogsNotUTF16(t *testing.T) {
+	tests := []string{
+		"Build step 1 completed successfully\n\x1b[1mSummary\x1b[0m: all good\n",
+		"plain ascii text with a tab\there and a bell \x07 in the middle of it\n",
+		"café \x1b[31mred\x1b[0m text in UTF-8, with naïve accents\n",
+		"hello ~{ world ~} \x1b[31m red",
+		// escapes at even offsets only, whose ESC [ reads as a CJK code unit
+		strings.Repeat("\x1b[32mINFO\x1b[0m request served in 12 ms\n", 40),
+		strings.Repeat("\x1b[31mОШИБКА\x1b[0m соединение разорвано\n", 20),
+	}
+
+	for _, text := range tests {
+		if res := Detect([]byte(text)); res.Encoding == consts.UTF16Le || res.Encoding == consts.UTF16Be {
+			t.Errorf("Detect(%q) = %s, did not expect UTF-16", text, res.Encoding)
+		}
+
+		d := NewUniversalDetector(consts.AllLangFilter)
+		for i := 0; i < len(text); i++ {
+			d.Feed([]byte{text[i]})
+		}
+		if res := d.GetResult(); res.Encoding == consts.UTF16Le || res.Encoding == consts.UTF16Be {
+			t.Errorf("Feed(%q) byte by byte = %s, did not expect UTF-16", text, res.Encoding)
+		}
+	}
+}
+
+func TestDetectCaucasianCharsets(t *testing.T) {
+	armenian := "Երեկ երեկոյան մենք գնացինք թատրոն և դիտեցինք նոր ներկայացում։ Դերասանները հիանալի էին խաղում, իսկ հանդիսատեսը երկար ծափահարում էր։"
+	georgian := "გუშინ საღამოს თეატრში წავედით და ახალი სპექტაკლი ვნახეთ. მსახიობები შესანიშნავად თამაშობდნენ, მაყურებელი კი დიდხანს უკრავდა ტაშს."
+
+	tests := []struct {
+		charset, language, text string
+	}{
+		{consts.Armscii8, consts.Armenian, armenian},
+		{consts.GeorgianPS, consts.Georgian, georgian},
+		{consts.GeorgianAcademy, consts.Georgian, georgian},
+	}
+
+	for _, tt := range tests {
+		enc, err := lookup.LookupEncoding(tt.charset)
+		if err != nil || enc == nil {
+			t.Fatalf("no decoder for %s: %v", tt.charset, err)
+		}
+
+		buf, err := enc.NewEncoder().Bytes([]byte(tt.text))
+		if err != nil {
+			t.Fatalf("failed to encode %s: %v", tt.charset, err)
+		}
+
+		res := Detect(buf)
+		if res.Charset != tt.charset || res.Language != tt.language {
+			t.Errorf("Detect(%s) = %s/%s, want %s/%s", tt.charset, res.Charset, res.Language, tt.charset, tt.language)
+		}
+	}
+}
+
+func TestDetectMacCharsets(t *testing.T) {
+	tests := []struct {
+		charset, language, text string
+	}{
+		{consts.MacCentralEurope, "", "Příliš žluťoučký kůň úpěl ďábelské ódy. Všichni lidé rodí se svobodní a sobě rovní co do důstojnosti a práv. Jsou nadáni rozumem a svědomím a mají spolu jednat v duchu bratrství."},
+		{consts.MacIcelandic, "", "Hver maður er borinn frjáls og jafn öðrum að virðingu og réttindum. Menn eru gæddir vitsmunum og samvisku, og ber þeim að breyta bróðurlega hverjum við annan."},
+		{consts.MacTurkish, "", "Bütün insanlar hür, haysiyet ve haklar bakımından eşit doğarlar. Akıl ve vicdana sahiptirler ve birbirlerine karşı kardeşlik zihniyeti ile hareket etmelidirler. Çocuklar öğretmenlerine teşekkür ettiler."},
+		{consts.MacGreek, consts.Greek, "Όλοι οι άνθρωποι γεννιούνται ελεύθεροι και ίσοι στην αξιοπρέπεια και τα δικαιώματα. Είναι προικισμένοι με λογική και συνείδηση, και οφείλουν να συμπεριφέρονται μεταξύ τους με πνεύμα αδελφοσύνης."},
+		{consts.MacArabic, consts.Arabic, "يولد جميع الناس أحرارا متساوين في الكرامة والحقوق. وقد وهبوا عقلا وضميرا وعليهم أن يعامل بعضهم بعضا بروح الإخاء. ولد في عام ١٩٤٨ وتوفي في عام ٢٠٢٠."},
+	}
+
+	for _, tt := range tests {
+		enc, err := lookup.LookupEncoding(tt.charset)
+		if err != nil || enc == nil {
+			t.Fatalf("no decoder for %s: %v", tt.charset, err)
+		}
+
+		buf, err := enc.NewEncoder().Bytes([]byte(tt.text))
+		if err != nil {
+			t.Fatalf("failed to encode %s: %v", tt.charset, err)
+		}
+
+		res := Detect(buf)
+		if res.Encoding != tt.charset || res.Language != tt.language {
+			t.Errorf("Detect(%s) = %s/%s, want %s/%s", tt.charset, res.Encoding, res.Language, tt.charset, tt.language)
+		}
+	}
+}
+
+func TestDetectIndicCharsets(t *testing.T) {
+	tests := []struct {
+		name, charset, language, text string
+	}{
+		{"ISCII", consts.ISCII, consts.Hindi, "सभी मनुष्यों को गौरव और अधिकारों के मामले में जन्मजात स्वतन्त्रता और समानता प्राप्त है। उन्हें बुद्धि और अन्तरात्मा की देन प्राप्त है।"},
+		{"ISCII", consts.ISCII, consts.Bengali, "সমস্ত মানুষ স্বাধীনভাবে সমান মর্যাদা এবং অধিকার নিয়ে জন্মগ্রহণ করে। তাঁদের বিবেক এবং বুদ্ধি আছে।"},
+		{"TSCII", consts.TSCII, consts.Tamil, "மனிதப் பிறிவியினர் சகலரும் சுதந்திரமாகவே பிறக்கின்றனர்; அவர்கள் மதிப்பிலும், உரிமைகளிலும் சமமானவர்கள். அவர்கள் நியாயத்தையும் மனசாட்சியையும் இயற்பண்பாகப் பெற்றவர்கள்."},
+	}
+
+	for _, tt := range tests {
+		enc, err := lookup.LookupEncoding(tt.name)
+		if err != nil || enc == nil {
+			t.Fatalf("no decoder for %s: %v", tt.name, err)
+		}
+
+		buf, err := enc.NewEncoder().Bytes([]byte(tt.text))
+		if err != nil {
+			t.Fatalf("failed to encode %s: %v", tt.name, err)
+		}
+
+		res := Detect(buf)
+		if res.Charset != tt.charset || res.Language != tt.language {
+			t.Errorf("Detect(%s) = %s/%s, want %s/%s", tt.language, res.Charset, res.Language, tt.charset, tt.language)
+		}
+	}
+}
+
+func TestDetectHebrewTextOrder(t *testing.T) {
+	logical, err := os.ReadFile("test/testdata/windows-1255-hebrew/_ude_he1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// visual Hebrew is stored the way it is displayed, each line reversed
+	lines := bytes.Split(bytes.Clone(logical), []byte("\n"))
+	for _, line := range lines {
+		slices.Reverse(line)
+	}
+	visual := bytes.Join(lines, []byte("\n"))
+
+	tests := []struct {
+		name     string
+		buf      []byte
+		encoding string
+		charset  string
+		visual   bool
+	}{
+		{"logical", logical, consts.Windows1255, consts.ISO88598I, false},
+		{"visual", visual, consts.ISO88598, consts.ISO88598, true},
+		{"logical with Windows bytes", append([]byte("\x93"), logical...), consts.Windows1255, consts.Windows1255, false},
+		{"logical with niqqud", bytes.Replace(logical, []byte(" "), []byte("\xC8 "), 1), consts.Windows1255, consts.Windows1255, false},
+	}
+
+	for _, tt := range tests {
+		res := Detect(tt.buf)
+		if res.Encoding != tt.encoding || res.Charset != tt.charset || res.Visual != tt.visual || res.Language != consts.Hebrew {
+			t.Errorf("%s: Detect() = %+v, want %s/%s visual=%v", tt.name, res, tt.encoding, tt.charset, tt.visual)
+		}
+	}
+}
+
+func TestDetectWindows1255NotMacHebrew(t *testing.T) {
+	text, err := os.ReadFile("test/testdata/windows-1255-hebrew/_ude_he1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// curly quotes are in the range Mac Hebrew uses for accented letters
+	quoted := bytes.ReplaceAll(text, []byte(`"`), []byte("\x93"))
+	tests := []struct {
+		name string
+		buf  []byte
+	}{
+		{"curly quotes", quoted},
+		{"curly quotes prefix", append([]byte("\x93\x94\x93\x94"), quoted...)},
+	}
+
+	for _, tt := range tests {
+		res := Detect(tt.buf)
+		if res.Encoding != consts.Windows1255 {
+			t.Errorf("%s: Detect() = %+v, want %s", tt.name, res, consts.Windows1255)
+		}
+	}
+}
+
+func TestDetectDeclaration(t *testing.T) {
+	hungarian, err := os.ReadFile("test/testdata/iso-8859-2-hungarian/hirtv.hu.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		buf      []byte
+		source   Source
+		declared string
+		conflict bool
+	}{
+		{"BOM", []byte("\xEF\xBB\xBF<meta charset=\"utf-8\">"), SourceBOM, "utf-8", false},
+		{"ASCII", []byte("<html><head><meta charset=\"koi8-r\"></head></html>"), SourceStatistics, "koi8-r", false},
+		{"agreeing", []byte("<meta charset=\"utf-8\">Служба запущена и ожидает подключений."), SourceStatistics, "utf-8", false},
+		{"conflicting", []byte("<meta charset=\"koi8-r\">Служба запущена и ожидает подключений."), SourceStatistics, "koi8-r", true},
+		// no probe models ISO-8859-2, and the Latin-1 fallback gives way
+		{"outweighing", hungarian, SourceDeclaration, "iso-8859-2", false},
+		{"undeclared", []byte("Служба запущена и ожидает подключений."), SourceStatistics, "", false},
+	}
+
+	for _, tt := range tests {
+		res := Detect(tt.buf)
+		if res.Source != tt.source || res.Declared != tt.declared || res.Conflict != tt.conflict {
+			t.Errorf("%s: Detect() = %+v, want source=%s declared=%q conflict=%v", tt.name, res, tt.source, tt.declared, tt.conflict)
+		}
+	}
+
+	// a declared encoding is reported under its consts name
+	if res := Detect(hungarian); res.Encoding != consts.ISO88592 || res.Charset != consts.ISO88592 {
+		t.Errorf("Detect(hungarian) = %+v, want %s", res, consts.ISO88592)
+	}
+}
+
+func TestDeclaredCharset(t *testing.T) {
+	tests := map[string]string{
+		"iso-8859-2":   consts.ISO88592,
+		"latin2":       consts.ISO88592,
+		"latin1":       consts.Windows1252,
+		"cp1250":       consts.Windows1250,
+		"x-sjis":       consts.ShiftJis,
+		"macintosh":    consts.MacRoman,
+		"iso-8859-8-i": consts.ISO88598I,
+		"gbk":          consts.GB2312,
+		"koi8-u":       "koi8-u",
+	}
+
+	for label, want := range tests {
+		if got := declaredCharset(declaration.New(label, declaration.HTML)); got != want {
+			t.Errorf("declaredCharset(%q) = %q, want %q", label, got, want)
+		}
+	}
+}
+
+func TestDetectCodingHint(t *testing.T) {
+	greek, err := lookup.LookupEncoding("windows-1253")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := greek.NewEncoder().Bytes([]byte("print('Η υπηρεσία ξεκίνησε')\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		header   string
+		charset  string
+		declared string
+		conflict bool
+	}{
+		{"no hint", "", consts.ISO88597, "", false},
+		// the Greek letters read the same in both encodings, so the hint decides
+		{"PEP 263", "#!/usr/bin/env python\n# -*- coding: cp1253 -*-\n", consts.Windows1253, "windows-1253", false},
+		{"Vim", "# vim: set fileencoding=cp1253 :\n", consts.Windows1253, "windows-1253", false},
+		{"contradicting", "# coding: koi8-r\n", consts.ISO88597, "koi8-r", true},
+	}
+
+	for _, tt := range tests {
+		res := Detect(append([]byte(tt.header), source...))
+		if res.Charset != tt.charset || res.Declared != tt.declared || res.Conflict != tt.conflict {
+			t.Errorf("%s: Detect() = %+v, want %s declared=%q conflict=%v", tt.name, res, tt.charset, tt.declared, tt.conflict)
+		}
+	}
+}
+
+func TestDetectExtractors(t *testing.T) {
+	koi8r, err := lookup.LookupEncoding("koi8-r")
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgstr, err := koi8r.NewEncoder().Bytes([]byte("Служба запущена и ожидает подключений на порту 8080."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	po := append([]byte("msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=windows-1251\\n\"\n\nmsgid \"Service started\"\nmsgstr \""), msgstr...)
+
+	res := Detect(po)
+	if res.Charset != consts.Koi8R || res.Declared != "windows-1251" || !res.Conflict {
+		t.Errorf("Detect(PO) = %+v, want KOI8-R in conflict with windows-1251", res)
+	}
+
+	// without extractors the declaration is not consulted
+	d := NewUniversalDetector(consts.AllLangFilter)
+	d.Extractors = nil
+	d.Feed(po)
+	if res := d.GetResult(); res.Declared != "" || res.Conflict {
+		t.Errorf("GetResult() without extractors = %+v, want no declaration", res)
+	}
+}
+
+func TestDetectHZTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		buf     string
+		charset string
+	}{
+		{"Go template", "{{ define \"row\" }}<td>~{ab~}</td>{{ end }}\n", "US-ASCII"},
+		{"Rust", "fn main() {\n    let s = \"~{xy~}\";\n    println!(\"{s}\");\n}\n", "US-ASCII"},
+		{"Jinja", "{% set x = \"~{AB~}\" %}\n{{ x }}\n", "US-ASCII"},
+		{"Handlebars", "{{~{raw}~}}\n", "US-ASCII"},
+		{"shell", "cp ~{HOME~}/bin/tool /usr/local/bin\n", "US-ASCII"},
+		{"mixed case", "~{AbCd~}", "US-ASCII"},
+		{"HZ", "~{VPND1`Bk<l2bPhR*Wc9;5DNDWV!#~}\n", "HZ-GB-2312"},
+		{"short HZ", "~{<:Ky~}\n", "HZ-GB-2312"},
+		{"short HZ in ASCII", "Say ~{<:Ky~} (hello).\n", "HZ-GB-2312"},
+	}
+
+	for _, tt := range tests {
+		if res := Detect([]byte(tt.buf)); res.Charset != tt.charset {
+			t.Errorf("Detect(%s) = %+v, want %s", tt.name, res, tt.charset)
+		}
+	}
+}
+
+func TestDetectHZTemplateChunks(t *testing.T) {
+	chunks := []string{
+		"tmpl := \"~{ name }\"\n",
+		"// Grüße aus München, wo die Straßen schön und die Brötchen frisch sind.\n",
+	}
+
+	d := NewUniversalDetector(consts.AllLangFilter)
+	for _, chunk := range chunks {
+		d.Feed([]byte(chunk))
+	}
+	if res := d.GetResult(); res.Charset != consts.UTF8 {
+		t.Errorf("GetResult() = %+v, want %s", res, consts.UTF8)
+	}
+}
+
+func TestDetectBinary(t *testing.T) {
+	// a table of little-endian integers, as found in executables and databases
+	var table []byte
+	for i := 0; i < 256; i++ {
+		table = append(table, byte(i*7), byte(i>>3), 0, 0)
+	}
+	utf16le, err := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewEncoder().Bytes([]byte("Service started and listening on port 8080.\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		buf    []byte
+		binary bool
+		reason string
+	}{
+		{"PNG", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), true, "PNG image signature"},
+		{"ZIP", []byte("PK\x03\x04\x14\x00\x00\x00\x08\x00"), true, "ZIP archive signature"},
+		{"PDF", []byte("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n"), true, "PDF document signature"},
+		{"MP4", []byte("\x00\x00\x00\x20ftypisom\x00\x00\x02\x00"), true, "ISO media signature"},
+		{"integers", table, true, "NUL bytes"},
+		{"control characters", []byte("\x01\x02\x03 header \x04\x05\x06\x07\x08\x10\x11"), true, "control characters"},
+		{"UTF-16", utf16le, false, ""},
+		{"short UTF-16", []byte("O\x00K\x00"), false, ""},
+		{"short UTF-16 Cyrillic", []byte("\x1f\x04@\x048\x042\x045\x04B\x04"), false, ""},
+		{"UTF-16 BOM", []byte("\xFF\xFEO\x00K\x00"), false, ""},
+		{"bzip2", []byte("BZh91AY&SY\x8e\x4b\x17\x2c\x00\x00"), true, "bzip2 data signature"},
+		{"text like bzip2", []byte("BZh is how bzip2 data starts."), false, ""},
+		{"text like RIFF", []byte("RIFF files hold chunks."), false, ""},
+		{"padded text", append(bytes.Repeat([]byte{0}, 512), "Service started."...), false, ""},
+		{"ISO-2022-JP", []byte("\x1b$B$3$s$K$A$O\x1b(B"), false, ""},
+	}
+
+	for _, tt := range tests {
+		res := Detect(tt.buf)
+		if res.Binary != tt.binary || res.Reason != tt.reason {
+			t.Errorf("Detect(%s) = %+v, want binary=%v reason=%q", tt.name, res, tt.binary, tt.reason)
+		}
+		if tt.binary && (res.Charset != "" || res.Encoding != "") {
+			t.Errorf("Detect(%s) = %+v, want no charset for binary data", tt.name, res)
+		}
+	}
+
+	if results := DetectAll(table); len(results) != 1 || !results[0].Binary {
+		t.Errorf("DetectAll(integers) = %+v, want a single binary result", results)
+	}
+
+	// text with a BOM of an encoding ruled out is left undetected
+	if res, err := DetectWith([]byte("\xFF\xFEO\x00K\x00"), WithCharsets("UTF-8")); err != nil || res.Binary || res.Charset != "" {
+		t.Errorf("DetectWith(UTF-16 BOM, UTF-8) = %+v, %v, want no result", res, err)
+	}
+}
+
+func TestPeek(t *testing.T) {
+	text, err := os.ReadFile("test/testdata/windows-1251-russian/_chromium_windows-1251_with_no_encoding_specified.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewUniversalDetector(consts.AllLangFilter)
+	if res := d.Peek(); res.Charset != "" {
+		t.Errorf("Peek() before Feed = %+v, want no result", res)
+	}
+
+	var peeked Result
+	for i := 0; i < len(text); i += 256 {
+		if !d.Feed(text[i:min(i+256, len(text))]) {
+			break
+		}
+		peeked = d.Peek()
+		if all := d.PeekAll(); all[0].Confidence < peeked.Confidence {
+			t.Errorf("PeekAll() = %+v, want the best of them first", all)
+		}
+	}
+
+	// the same chunks without peeking
+	plain := NewUniversalDetector(consts.AllLangFilter)
+	for i := 0; i < len(text) && plain.Feed(text[i:min(i+256, len(text))]); i += 256 {
+	}
+
+	res := d.GetResult()
+	if want := plain.GetResult(); res != want {
+		t.Errorf("GetResult() after Peek = %+v, want %+v", res, want)
+	}
+	if peeked.Charset != res.Charset {
+		t.Errorf("last Peek() = %+v, want %s", peeked, res.Charset)
+	}
+	if d.Peek() != res {
+		t.Errorf("Peek() after GetResult = %+v, want %+v", d.Peek(), res)
+	}
+}
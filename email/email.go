@@ -0,0 +1,273 @@
+// Package email checks the charsets of an RFC 5322 message: the charset
+// parameter of each MIME part, raw 8-bit header fields and RFC 2047
+// encoded-words. Mail is often mislabelled, so every declared charset is
+// compared with the one the bytes are detected in.
+package email
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wlynxg/chardet"
+	"github.com/wlynxg/chardet/consts"
+)
+
+// Report is the charset analysis of a message.
+type Report struct {
+	// Headers lists the raw 8-bit header values and the encoded-words
+	Headers []Header
+	// Parts lists the text parts of the message
+	Parts []Part
+}
+
+// Header is a piece of header text: a raw 8-bit field value or the payload of
+// an RFC 2047 encoded-word.
+type Header struct {
+	// Path is the part the header belongs to, empty for the message itself
+	Path string
+	// Name is the header field name
+	Name string
+	// Charset is the label of the encoded-word, empty for raw 8-bit text
+	Charset string
+	// Text is the raw value or the decoded payload of the encoded-word
+	Text []byte
+	// Result is the detected encoding of Text
+	Result chardet.Result
+	// Conflict reports that Charset disagrees with Result
+	Conflict bool
+}
+
+// Part is a text part of a message.
+type Part struct {
+	// Path is the IMAP section number of the part, as in "1.2" for the
+	// second part of the first part
+	Path string
+	// ContentType is the media type of the part, as in "text/plain"
+	ContentType string
+	// Charset is the charset parameter of the Content-Type field, or
+	// "us-ascii" when it is missing, as RFC 2045 defines
+	Charset string
+	// TransferEncoding is the Content-Transfer-Encoding the body was decoded
+	// from before detection
+	TransferEncoding string
+	// Result is the detected encoding of the decoded body
+	Result chardet.Result
+	// Conflict reports that Charset disagrees with Result
+	Conflict bool
+}
+
+// Analyze reads the message from r and detects the encoding of its header
+// text and of its text parts.
+func Analyze(r io.Reader) (*Report, error) {
+	msg, err := mail.ReadMessage(bufio.NewReader(r))
+	if err != nil {
+		return nil, fmt.Errorf("email: %w", err)
+	}
+
+	report := &Report{}
+	if err := report.message("", textproto.MIMEHeader(msg.Header), msg.Body); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// message analyzes the headers and body of a message or an entity.
+func (r *Report) message(path string, header textproto.MIMEHeader, body io.Reader) error {
+	r.headers(path, header)
+
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		// RFC 2045 defaults a missing or broken Content-Type to plain text
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		return r.multipart(path, body, params["boundary"])
+	case mediaType == "message/rfc822":
+		msg, err := mail.ReadMessage(bufio.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("email: part %s: %w", section(path), err)
+		}
+		return r.message(path, textproto.MIMEHeader(msg.Header), msg.Body)
+	case !strings.HasPrefix(mediaType, "text/") && params["charset"] == "":
+		return nil
+	}
+
+	encoding := strings.ToLower(strings.TrimSpace(header.Get("Content-Transfer-Encoding")))
+	text, err := decodeBody(body, encoding)
+	if err != nil {
+		return fmt.Errorf("email: part %s: %w", section(path), err)
+	}
+
+	charset := params["charset"]
+	if charset == "" {
+		charset = "us-ascii"
+	}
+
+	res := detect(text)
+	r.Parts = append(r.Parts, Part{
+		Path:             section(path),
+		ContentType:      mediaType,
+		Charset:          charset,
+		TransferEncoding: encoding,
+		Result:           res,
+		Conflict:         conflicts(res, charset),
+	})
+	return nil
+}
+
+// multipart analyzes each body part of a multipart entity.
+func (r *Report) multipart(path string, body io.Reader, boundary string) error {
+	if boundary == "" {
+		return fmt.Errorf("email: part %s: multipart without boundary", section(path))
+	}
+
+	mr := multipart.NewReader(body, boundary)
+	for i := 1; ; i++ {
+		// NextRawPart leaves the Content-Transfer-Encoding to decodeBody
+		p, err := mr.NextRawPart()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("email: part %s: %w", section(path), err)
+		}
+
+		sub := strconv.Itoa(i)
+		if path != "" {
+			sub = path + "." + sub
+		}
+		if err := r.message(sub, p.Header, p); err != nil {
+			return err
+		}
+	}
+}
+
+// encodedWord matches an RFC 2047 encoded-word. The charset may carry an
+// RFC 2231 language suffix.
+var encodedWord = regexp.MustCompile(`=\?([^?*\s]+)(?:\*[^?\s]*)?\?([bBqQ])\?([^?\s]*)\?=`)
+
+// headers analyzes the raw 8-bit values and the encoded-words of a header.
+func (r *Report) headers(path string, header textproto.MIMEHeader) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range header[name] {
+			if chardet.HighByteDetector([]byte(value)) {
+				res := detect([]byte(value))
+				r.Headers = append(r.Headers, Header{Path: path, Name: name, Text: []byte(value), Result: res})
+			}
+
+			for _, m := range encodedWord.FindAllStringSubmatch(value, -1) {
+				text, ok := decodeWord(m[2], m[3])
+				if !ok {
+					continue
+				}
+				res := detect(text)
+				r.Headers = append(r.Headers, Header{
+					Path:     path,
+					Name:     name,
+					Charset:  m[1],
+					Text:     text,
+					Result:   res,
+					Conflict: conflicts(res, m[1]),
+				})
+			}
+		}
+	}
+}
+
+// decodeWord decodes the payload of an encoded-word in the B or Q encoding.
+func decodeWord(encoding, payload string) ([]byte, bool) {
+	if strings.EqualFold(encoding, "b") {
+		text, err := base64.StdEncoding.DecodeString(payload)
+		return text, err == nil
+	}
+
+	var text []byte
+	for i := 0; i < len(payload); i++ {
+		switch c := payload[i]; {
+		case c == '_':
+			text = append(text, ' ')
+		case c == '=' && i+2 < len(payload):
+			b, err := strconv.ParseUint(payload[i+1:i+3], 16, 8)
+			if err != nil {
+				return nil, false
+			}
+			text = append(text, byte(b))
+			i += 2
+		case c == '=':
+			return nil, false
+		default:
+			text = append(text, c)
+		}
+	}
+	return text, true
+}
+
+// decodeBody undoes the Content-Transfer-Encoding of a body.
+func decodeBody(body io.Reader, encoding string) ([]byte, error) {
+	switch encoding {
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		// the decoder skips line breaks but not other whitespace
+		raw, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		raw = bytes.Map(func(r rune) rune {
+			if r == ' ' || r == '\t' {
+				return -1
+			}
+			return r
+		}, raw)
+		body = base64.NewDecoder(base64.StdEncoding, bytes.NewReader(raw))
+	}
+	return io.ReadAll(body)
+}
+
+func detect(text []byte) chardet.Result {
+	d := chardet.NewUniversalDetector(consts.AllLangFilter)
+	d.Feed(text)
+	return d.GetResult()
+}
+
+// conflicts reports whether text detected as res cannot be read in the
+// declared charset.
+func conflicts(res chardet.Result, charset string) bool {
+	if res.Charset == "" {
+		return false
+	}
+	if strings.EqualFold(charset, "us-ascii") || strings.EqualFold(charset, "ascii") {
+		// browsers read US-ASCII as windows-1252, but in mail it means 7-bit
+		return res.Charset != consts.CanonicalCharset(consts.Ascii)
+	}
+	return !res.Matches(charset)
+}
+
+// section returns the path of the message itself as "1".
+func section(path string) string {
+	if path == "" {
+		return "1"
+	}
+	return path
+}
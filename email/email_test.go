@@ -0,0 +1,130 @@
+package email
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime/quotedprintable"
+	"strings"
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/lookup"
+)
+
+const russian = "Служба запущена и ожидает подключений на порту 8080. Пользователь вошёл в систему, сеанс установлен."
+
+func encode(t *testing.T, charset, text string) []byte {
+	t.Helper()
+	enc, err := lookup.LookupEncoding(charset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := enc.NewEncoder().Bytes([]byte(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestAnalyze(t *testing.T) {
+	cp1251 := encode(t, "windows-1251", russian)
+	subject := encode(t, "koi8-r", "Отчёт о работе службы за неделю")
+
+	var qp bytes.Buffer
+	w := quotedprintable.NewWriter(&qp)
+	w.Write(cp1251)
+	w.Close()
+
+	msg := fmt.Sprintf("From: service@example.com\r\n"+
+		"Subject: =?iso-8859-1?B?%s?=\r\n"+
+		"X-Comment: %s\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: multipart/mixed; boundary=\"b1\"\r\n"+
+		"\r\n"+
+		"--b1\r\n"+
+		"Content-Type: text/plain; charset=windows-1251\r\n"+
+		"Content-Transfer-Encoding: base64\r\n"+
+		"\r\n%s\r\n"+
+		"--b1\r\n"+
+		"Content-Type: text/plain; charset=\"iso-8859-1\"\r\n"+
+		"Content-Transfer-Encoding: quoted-printable\r\n"+
+		"\r\n%s\r\n"+
+		"--b1\r\n"+
+		"Content-Type: application/octet-stream\r\n"+
+		"\r\n\x00\x01\x02\r\n"+
+		"--b1--\r\n",
+		base64.StdEncoding.EncodeToString(subject), cp1251, base64.StdEncoding.EncodeToString(cp1251), qp.String())
+
+	report, err := Analyze(strings.NewReader(msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantParts := []Part{
+		{Path: "1", ContentType: "text/plain", Charset: "windows-1251", TransferEncoding: "base64"},
+		{Path: "2", ContentType: "text/plain", Charset: "iso-8859-1", TransferEncoding: "quoted-printable", Conflict: true},
+	}
+	if len(report.Parts) != len(wantParts) {
+		t.Fatalf("Analyze() found %d parts, want %d: %+v", len(report.Parts), len(wantParts), report.Parts)
+	}
+	for i, want := range wantParts {
+		got := report.Parts[i]
+		if got.Result.Charset != consts.Windows1251 {
+			t.Errorf("part %s detected as %s, want %s", got.Path, got.Result.Charset, consts.Windows1251)
+		}
+		got.Result = want.Result
+		if got != want {
+			t.Errorf("part %d = %+v, want %+v", i, got, want)
+		}
+	}
+
+	wantHeaders := []struct {
+		name, charset, detected string
+		conflict                bool
+	}{
+		{"Subject", "iso-8859-1", consts.Koi8R, true},
+		{"X-Comment", "", consts.Windows1251, false},
+	}
+	if len(report.Headers) != len(wantHeaders) {
+		t.Fatalf("Analyze() found %d headers, want %d: %+v", len(report.Headers), len(wantHeaders), report.Headers)
+	}
+	for i, want := range wantHeaders {
+		got := report.Headers[i]
+		if got.Name != want.name || got.Charset != want.charset || got.Result.Charset != want.detected || got.Conflict != want.conflict {
+			t.Errorf("header %d = %s %q detected as %s conflict=%v, want %s %q detected as %s conflict=%v",
+				i, got.Name, got.Charset, got.Result.Charset, got.Conflict, want.name, want.charset, want.detected, want.conflict)
+		}
+	}
+}
+
+func TestDecodeWord(t *testing.T) {
+	tests := []struct {
+		encoding, payload, want string
+		ok                      bool
+	}{
+		{"Q", "caf=E9_au_lait", "caf\xe9 au lait", true},
+		{"q", "bad=G1", "", false},
+		{"B", "Y2Fm6Q==", "caf\xe9", true},
+		{"b", "not base64", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := decodeWord(tt.encoding, tt.payload)
+		if string(got) != tt.want || ok != tt.ok {
+			t.Errorf("decodeWord(%s, %q) = %q, %v, want %q, %v", tt.encoding, tt.payload, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestUnlabelledPart(t *testing.T) {
+	msg := "Subject: test\r\n\r\n" + string(encode(t, "windows-1251", russian))
+
+	report, err := Analyze(strings.NewReader(msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Parts) != 1 || report.Parts[0].Charset != "us-ascii" || !report.Parts[0].Conflict {
+		t.Errorf("Analyze() = %+v, want a us-ascii part in conflict", report.Parts)
+	}
+}
@@ -0,0 +1,242 @@
+package chardet
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/probe"
+)
+
+// Trace explains a detection: what every probe concluded and how the result
+// was picked from them.
+type Trace struct {
+	// Result is the result for the data fed so far
+	Result Result
+	// Fed is the number of bytes fed
+	Fed int
+	// Input is what the detector saw in the bytes fed: "none", "ASCII",
+	// "escapes" or "high bytes". The charset probes only run on high bytes,
+	// the escape sequence probe on escapes.
+	Input string
+	// HasWinBytes reports that bytes 0x80-0x9F were seen, which makes the
+	// ISO-8859 charsets of IsoWinMap report as their Windows counterparts
+	HasWinBytes bool
+	// MinimumThreshold is the confidence a charset probe must exceed for
+	// its answer to be reported
+	MinimumThreshold float64
+	// Probes lists the probes that ran, the members of a group after the
+	// group and the encodings a probe weighs on its own after the probe
+	Probes []ProbeTrace
+}
+
+// ProbeTrace is what a probe, or an encoding it weighs on its own, concluded.
+type ProbeTrace struct {
+	// Probe is the type of the probe, such as "SingleByteCharSetProbe", or
+	// "state machine" and "byte order" for the encodings EscCharSetProbe and
+	// UTF1632Probe weigh
+	Probe string
+	// Group is the probe this one belongs to, if any
+	Group    string
+	Charset  string
+	Language string
+	// State is "detecting", "found it" or "not me"
+	State string
+	// Active is false for the members a group stopped feeding
+	Active bool
+	// Confidence is the confidence of the probe, and Weighed the same once
+	// the hints are taken into account
+	Confidence, Weighed float64
+	// Offset is where in the input the probe stopped detecting, for the
+	// Reason given, or -1 while detecting. Text probes count the bytes left
+	// once terminal escapes are removed.
+	Offset int
+	Reason string
+	// Renamed is the charset reported instead of Charset because of
+	// HasWinBytes
+	Renamed string
+	// BelowThreshold reports that Weighed does not exceed MinimumThreshold
+	BelowThreshold bool
+}
+
+// Explain reports what every probe concluded from the data fed so far and how
+// the result was picked, to find out why a detection went wrong. Like Peek, it
+// does not end detection.
+func (u *UniversalDetector) Explain() Trace {
+	t := Trace{
+		Result:           u.Peek(),
+		Fed:              u.fed,
+		HasWinBytes:      u.hasWinBytes,
+		MinimumThreshold: u.MinimumThreshold,
+	}
+
+	t.Input = inputStateName(u.inputState)
+	if !u.gotData {
+		t.Input = "none"
+	}
+
+	if u.binaryProbe != nil {
+		pt := traceProbe(u.binaryProbe, "")
+		if binary, reason := u.binaryProbe.Binary(); binary && pt.Reason == "" {
+			pt.State, pt.Offset, pt.Reason = "found it", u.fed, reason
+		}
+		t.Probes = append(t.Probes, pt)
+	}
+	if u.utf1632Probe != nil {
+		t.Probes = append(t.Probes, traceProbe(u.utf1632Probe, ""))
+		t.Probes = append(t.Probes, traceCandidates(u.utf1632Probe, "byte order")...)
+	}
+	if u.utf16BlockProbe != nil {
+		t.Probes = append(t.Probes, traceProbe(u.utf16BlockProbe, ""))
+	}
+	if u.escCharsetProbe != nil {
+		t.Probes = append(t.Probes, traceProbe(u.escCharsetProbe, ""))
+		t.Probes = append(t.Probes, traceCandidates(u.escCharsetProbe, "state machine")...)
+	}
+
+	for _, p := range u.charsetProbes {
+		if p == nil {
+			continue
+		}
+		t.Probes = append(t.Probes, u.traceCharsetProbe(p, ""))
+		if g, ok := p.(interface{ Probes() []probe.Probe }); ok {
+			for _, member := range g.Probes() {
+				pt := u.traceCharsetProbe(member, probeType(p))
+				pt.Active = member.IsActive()
+				t.Probes = append(t.Probes, pt)
+			}
+		}
+	}
+	return t
+}
+
+// traceProbe reports the state of p, a member of group if not empty.
+func traceProbe(p probe.Probe, group string) ProbeTrace {
+	pt := ProbeTrace{
+		Probe:      probeType(p),
+		Group:      group,
+		Charset:    p.CharSetName(),
+		Language:   p.Language(),
+		State:      "detecting",
+		Active:     true,
+		Confidence: p.GetConfidence(),
+		Offset:     -1,
+	}
+	pt.Weighed = pt.Confidence
+
+	if s, ok := p.(interface{ State() consts.ProbingState }); ok {
+		pt.State = stateName(s.State())
+	}
+	if v, ok := p.(interface{ Verdict() probe.Verdict }); ok && v.Verdict().Reason != "" {
+		pt.Offset, pt.Reason = v.Verdict().Offset, v.Verdict().Reason
+	}
+	return pt
+}
+
+// traceCharsetProbe reports the state of a charset probe, with the hints,
+// renaming and threshold that finalize applies to it.
+func (u *UniversalDetector) traceCharsetProbe(p probe.Probe, group string) ProbeTrace {
+	pt := traceProbe(p, group)
+	res := u.weigh(p)
+	pt.Weighed = res.Confidence
+	pt.BelowThreshold = res.Confidence <= u.MinimumThreshold
+	if n, ok := u.IsoWinMap[p.CharSetName()]; ok && u.hasWinBytes {
+		pt.Renamed = n
+	}
+	return pt
+}
+
+// traceCandidates reports the encodings p weighs on its own, kind telling
+// what they are.
+func traceCandidates(p probe.Probe, kind string) []ProbeTrace {
+	c, ok := p.(interface{ Candidates() []probe.Candidate })
+	if !ok {
+		return nil
+	}
+
+	var traces []ProbeTrace
+	for _, candidate := range c.Candidates() {
+		pt := ProbeTrace{
+			Probe:    kind,
+			Group:    probeType(p),
+			Charset:  candidate.Charset,
+			Language: candidate.Language,
+			State:    "detecting",
+			Active:   !candidate.RuledOut,
+			Offset:   -1,
+		}
+		if candidate.RuledOut {
+			pt.State = "not me"
+			pt.Offset, pt.Reason = candidate.Verdict.Offset, candidate.Verdict.Reason
+		}
+		traces = append(traces, pt)
+	}
+	return traces
+}
+
+// probeType names the type of p, without its package.
+func probeType(p probe.Probe) string {
+	name := fmt.Sprintf("%T", p)
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func stateName(state consts.ProbingState) string {
+	switch state {
+	case consts.FoundItProbingState:
+		return "found it"
+	case consts.NotMeProbingState:
+		return "not me"
+	default:
+		return "detecting"
+	}
+}
+
+// String formats the trace as a table, one probe per line.
+func (t Trace) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "result: %s", t.Result.Charset)
+	if t.Result.Binary {
+		fmt.Fprintf(&b, "binary (%s)", t.Result.Reason)
+	}
+	if t.Result.Language != "" {
+		fmt.Fprintf(&b, " (%s)", t.Result.Language)
+	}
+	fmt.Fprintf(&b, ", confidence %.2f, from %s\n", t.Result.Confidence, t.Result.Source)
+	fmt.Fprintf(&b, "input: %s in %d bytes", t.Input, t.Fed)
+	if t.HasWinBytes {
+		b.WriteString(", with Windows bytes 0x80-0x9F")
+	}
+	fmt.Fprintf(&b, "\nminimum threshold: %.2f\n\n", t.MinimumThreshold)
+
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROBE\tCHARSET\tLANGUAGE\tSTATE\tCONFIDENCE\tOFFSET\tREASON")
+	for _, p := range t.Probes {
+		name := p.Probe
+		if p.Group != "" {
+			name = "  " + name
+		}
+		charset := p.Charset
+		if p.Renamed != "" {
+			charset += " -> " + p.Renamed
+		}
+		state := p.State
+		if !p.Active && p.State == "detecting" {
+			state = "inactive"
+		}
+		confidence := fmt.Sprintf("%.2f", p.Confidence)
+		if p.Weighed != p.Confidence {
+			confidence += fmt.Sprintf(" (%.2f)", p.Weighed)
+		}
+		if p.BelowThreshold {
+			confidence += " <"
+		}
+		offset := "-"
+		if p.Offset >= 0 {
+			offset = fmt.Sprint(p.Offset)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", name, charset, p.Language, state, confidence, offset, p.Reason)
+	}
+	w.Flush()
+	return b.String()
+}
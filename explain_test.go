@@ -0,0 +1,55 @@
+package chardet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+func findTrace(t Trace, probe, charset string) (ProbeTrace, bool) {
+	for _, p := range t.Probes {
+		if p.Probe == probe && p.Charset == charset {
+			return p, true
+		}
+	}
+	return ProbeTrace{}, false
+}
+
+func TestExplain(t *testing.T) {
+	d := NewUniversalDetector(consts.AllLangFilter)
+	d.Feed([]byte("Caf\xe9 cr\xe8me br\xfbl\xe9e, "))
+	d.Feed([]byte("\x93d\xe9licieux\x94 disait-il \xe0 la cantine\xff"))
+	trace := d.Explain()
+
+	if trace.Result != d.Peek() || trace.Input != "high bytes" || !trace.HasWinBytes || trace.Fed != 54 {
+		t.Errorf("Explain() = %+v, want the high bytes and Windows bytes of 54 bytes", trace)
+	}
+	utf8, ok := findTrace(trace, "UTF8Probe", consts.UTF8)
+	if !ok || utf8.State != "not me" || utf8.Offset != 4 || !strings.Contains(utf8.Reason, "0x20") {
+		t.Errorf("UTF8Probe trace = %+v, want ruled out at offset 4 by the space after 0xE9", utf8)
+	}
+	latin1, ok := findTrace(trace, "Latin1Probe", consts.ISO88591)
+	if !ok || latin1.Renamed != consts.Windows1252 {
+		t.Errorf("Latin1Probe trace = %+v, want renamed %s", latin1, consts.Windows1252)
+	}
+	for _, p := range trace.Probes {
+		if p.Group == "SBCSGroupProbe" && p.BelowThreshold != (p.Weighed <= trace.MinimumThreshold) {
+			t.Errorf("%s trace = %+v, want BelowThreshold against %v", p.Probe, p, trace.MinimumThreshold)
+		}
+	}
+	if s := trace.String(); !strings.Contains(s, "ISO-8859-1 -> Windows-1252") {
+		t.Errorf("Trace.String() = %s, want the renaming of ISO-8859-1", s)
+	}
+
+	d = NewUniversalDetector(consts.AllLangFilter)
+	d.Feed([]byte("Hello \x1b$B$3$s$K$A$O\x1b(B"))
+	trace = d.Explain()
+	esc, ok := findTrace(trace, "EscCharSetProbe", consts.ISO2022JP)
+	if !ok || esc.State != "found it" || esc.Offset != 8 {
+		t.Errorf("EscCharSetProbe trace = %+v, want ISO-2022-JP found at offset 8", esc)
+	}
+	if kr, ok := findTrace(trace, "state machine", consts.ISO2022KR); !ok || kr.State != "not me" || kr.Offset != 8 {
+		t.Errorf("ISO-2022-KR trace = %+v, want ruled out at offset 8", kr)
+	}
+}
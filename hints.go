@@ -0,0 +1,66 @@
+package chardet
+
+import (
+	"math"
+	"strings"
+
+	"github.com/wlynxg/chardet/probe"
+)
+
+// Hint is an expectation of the caller about the encoding, such as the charset
+// of an HTTP Content-Type, the language of the user's locale or the usual
+// encoding of a tenant. Hints act as prior probabilities: they tip the balance
+// between probes that are about as confident, and barely move a clear answer.
+type Hint struct {
+	// Charset is the expected charset, compared as by Result.Matches
+	Charset string
+	// Language is the expected language, such as "Japanese"
+	Language string
+	// Weight tells how much likelier a match is than the alternatives: the
+	// odds of the probes that match are multiplied by 1+Weight, so that a
+	// Weight of 1 doubles them. A Weight between -1 and 0 makes a match less
+	// likely, and hints with a Weight of -1 or less are ignored.
+	Weight float64
+}
+
+// matches reports whether a probe that can report the charsets names and
+// reports res meets the hint. A hint with both a charset and a language needs
+// both to match.
+func (h Hint) matches(names []string, res Result) bool {
+	if h.Language != "" && !strings.EqualFold(h.Language, res.Language) {
+		return false
+	}
+	if h.Charset == "" {
+		return true
+	}
+	for _, name := range names {
+		if r := newResult(name, 0, ""); r.Matches(h.Charset) || listsCharset([]string{h.Charset}, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// weigh returns the result of p with its confidence updated by the hints that
+// p matches, as a Bayesian update of the odds.
+func (u *UniversalDetector) weigh(p probe.Probe) Result {
+	res := u.probeResult(p)
+	if len(u.Hints) == 0 || res.Confidence <= 0 || res.Confidence >= 1 {
+		return res
+	}
+
+	odds := res.Confidence / (1 - res.Confidence)
+	names := u.probeCharsets(p)
+	for _, h := range u.Hints {
+		// a Weight of -1 or less would leave odds of zero or below
+		if h.Weight > -1 && h.matches(names, res) {
+			odds *= 1 + h.Weight
+		}
+	}
+	if math.IsInf(odds, 1) {
+		res.Confidence = 1
+	} else {
+		res.Confidence = odds / (1 + odds)
+	}
+	return res
+}
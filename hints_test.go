@@ -0,0 +1,66 @@
+package chardet
+
+import (
+	"os"
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+func TestDetectHints(t *testing.T) {
+	// "日本語" in Shift_JIS, too short for the probes to tell
+	short := []byte("\x93\xfa\x96\x7b\x8c\xea")
+	russian, err := os.ReadFile("test/testdata/windows-1251-russian/_chromium_windows-1251_with_no_encoding_specified.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		buf     []byte
+		hints   []Hint
+		charset string
+	}{
+		{"no hint", short, nil, "Windows-1252"},
+		{"weak hint", short, []Hint{{Charset: "CP932", Weight: 1}}, "Windows-1252"},
+		{"charset", short, []Hint{{Charset: "CP932", Weight: 25}}, "Shift_JIS"},
+		{"language", short, []Hint{{Language: "japanese", Weight: 25}}, "Shift_JIS"},
+		{"both", short, []Hint{{Charset: "EUC-KR", Language: "Japanese", Weight: 25}}, "Windows-1252"},
+		{"against", short, []Hint{{Charset: "CP932", Weight: 25}, {Language: "Japanese", Weight: -0.99}}, "Windows-1252"},
+		{"clear answer", russian, []Hint{{Charset: "KOI8-R", Weight: 25}}, "Windows-1251"},
+	}
+
+	for _, tt := range tests {
+		res, err := DetectWith(tt.buf, WithHints(tt.hints...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Charset != tt.charset {
+			t.Errorf("DetectWith(%s) = %+v, want %s", tt.name, res, tt.charset)
+		}
+	}
+
+	results, err := DetectAllWith(short, WithHints(Hint{Charset: "Shift_JIS", Weight: 25}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Charset != "Shift_JIS" {
+		t.Errorf("DetectAllWith() = %+v, want Shift_JIS first", results)
+	}
+
+	for _, h := range []Hint{{Weight: 1}, {Charset: "CP932", Weight: -1}} {
+		if _, err := New(WithHints(h)); err == nil {
+			t.Errorf("New(WithHints(%+v)) succeeded, want an error", h)
+		}
+	}
+
+	// set on the detector, hints WithHints rejects are ignored
+	for _, h := range []Hint{{Charset: "Windows-1252", Weight: -3}, {Charset: "Windows-1252", Weight: -1}} {
+		d := NewUniversalDetector(consts.AllLangFilter)
+		d.Hints = []Hint{h}
+		d.Feed(short)
+		if res := d.GetResult(); res.Charset != "Windows-1252" || res.Confidence < 0 || res.Confidence > 1 {
+			t.Errorf("GetResult() with hint %+v = %+v, want Windows-1252", h, res)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// Package state encodes the state of detectors and probes in a compact binary
+// form, so that detection can be paused and resumed elsewhere.
+package state
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// ErrCorrupt reports state that cannot be decoded.
+var ErrCorrupt = errors.New("corrupt detector state")
+
+// Encoder appends values to a buffer. After the first error, Err reports it.
+type Encoder struct {
+	buf []byte
+	err error
+}
+
+// Bytes returns the encoded values.
+func (e *Encoder) Bytes() []byte {
+	return e.buf
+}
+
+// Err returns the first error met.
+func (e *Encoder) Err() error {
+	return e.err
+}
+
+// Fail records err, unless an error was met before.
+func (e *Encoder) Fail(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *Encoder) Int(v int) {
+	e.buf = binary.AppendVarint(e.buf, int64(v))
+}
+
+func (e *Encoder) Byte(v byte) {
+	e.buf = append(e.buf, v)
+}
+
+func (e *Encoder) Bool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *Encoder) Float(v float64) {
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(v))
+}
+
+// Raw appends b prefixed by its length.
+func (e *Encoder) Raw(b []byte) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *Encoder) String(s string) {
+	e.Raw([]byte(s))
+}
+
+func (e *Encoder) Ints(v []int) {
+	e.Int(len(v))
+	for _, n := range v {
+		e.Int(n)
+	}
+}
+
+func (e *Encoder) Strings(v []string) {
+	e.Int(len(v))
+	for _, s := range v {
+		e.String(s)
+	}
+}
+
+// Marshal appends the encoding of m prefixed by its length.
+func (e *Encoder) Marshal(m encoding.BinaryMarshaler) {
+	b, err := m.MarshalBinary()
+	if err != nil {
+		e.Fail(err)
+		return
+	}
+	e.Raw(b)
+}
+
+// Decoder reads back the values of an Encoder, in the same order. After the
+// first error, it returns zero values and Err reports the error.
+type Decoder struct {
+	buf []byte
+	err error
+}
+
+func NewDecoder(b []byte) *Decoder {
+	return &Decoder{buf: b}
+}
+
+// Err returns the first error met, or ErrCorrupt if values are left over.
+func (d *Decoder) Err() error {
+	if d.err == nil && len(d.buf) > 0 {
+		return ErrCorrupt
+	}
+	return d.err
+}
+
+// Fail records err, unless an error was met before.
+func (d *Decoder) Fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+func (d *Decoder) Int() int {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.Fail(ErrCorrupt)
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return int(v)
+}
+
+// Range reads an int that must lie in [lo, hi), such as an index into a table.
+// Out of range, it fails with ErrCorrupt and returns lo.
+func (d *Decoder) Range(lo, hi int) int {
+	v := d.Int()
+	if v < lo || v >= hi {
+		d.Fail(ErrCorrupt)
+		return lo
+	}
+	return v
+}
+
+func (d *Decoder) Byte() byte {
+	if d.err != nil || len(d.buf) < 1 {
+		d.Fail(ErrCorrupt)
+		return 0
+	}
+	v := d.buf[0]
+	d.buf = d.buf[1:]
+	return v
+}
+
+func (d *Decoder) Bool() bool {
+	return d.Byte() != 0
+}
+
+func (d *Decoder) Float() float64 {
+	if d.err != nil || len(d.buf) < 8 {
+		d.Fail(ErrCorrupt)
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.buf))
+	d.buf = d.buf[8:]
+	return v
+}
+
+// Raw reads bytes prefixed by their length.
+func (d *Decoder) Raw() []byte {
+	if d.err != nil {
+		return nil
+	}
+	l, n := binary.Uvarint(d.buf)
+	if n <= 0 || l > uint64(len(d.buf)-n) {
+		d.Fail(ErrCorrupt)
+		return nil
+	}
+	v := append([]byte(nil), d.buf[n:n+int(l)]...)
+	d.buf = d.buf[n+int(l):]
+	return v
+}
+
+func (d *Decoder) String() string {
+	return string(d.Raw())
+}
+
+// Count reads the length of a list, which cannot exceed the bytes left.
+func (d *Decoder) Count() int {
+	l := d.Int()
+	if l < 0 || l > len(d.buf) {
+		d.Fail(ErrCorrupt)
+		return 0
+	}
+	return l
+}
+
+func (d *Decoder) Ints() []int {
+	l := d.Count()
+	if d.err != nil {
+		return nil
+	}
+	v := make([]int, l)
+	for i := range v {
+		v[i] = d.Int()
+	}
+	return v
+}
+
+// Strings reads the strings appended by Encoder.Strings. An empty list reads
+// as nil.
+func (d *Decoder) Strings() []string {
+	l := d.Count()
+	if l == 0 {
+		return nil
+	}
+	v := make([]string, l)
+	for i := range v {
+		v[i] = d.String()
+	}
+	return v
+}
+
+// Unmarshal reads bytes prefixed by their length into u.
+func (d *Decoder) Unmarshal(u encoding.BinaryUnmarshaler) {
+	b := d.Raw()
+	if d.err != nil {
+		return
+	}
+	if err := u.UnmarshalBinary(b); err != nil {
+		d.Fail(err)
+	}
+}
@@ -0,0 +1,104 @@
+package chardet
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+// jsonBOMs lists the byte order marks of the JSON encodings. RFC 8259 forbids
+// them, but allows parsers to ignore them.
+var jsonBOMs = []struct {
+	bom, encoding string
+}{
+	{consts.UTF8BOM, consts.UTF8SIG},
+	{consts.UTF32LEBOM, consts.UTF32Le},
+	{consts.UTF32BEBOM, consts.UTF32Be},
+	{consts.UTF16LEBOM, consts.UTF16Le},
+	{consts.UTF16BEBOM, consts.UTF16Be},
+}
+
+// DetectJSON detects the encoding of a JSON text. JSON is written in UTF-8,
+// UTF-16 or UTF-32, and since its first two characters are ASCII, the pattern
+// of zero bytes in the first four bytes tells which (RFC 4627, section 3):
+//
+//	00 00 00 xx  UTF-32BE
+//	00 xx 00 xx  UTF-16BE
+//	xx 00 00 00  UTF-32LE
+//	xx 00 xx 00  UTF-16LE
+//	xx xx xx xx  UTF-8
+//
+// The probes are only consulted when the pattern does not apply, and the
+// result is always one of the JSON encodings, UTF-8 being the default.
+func DetectJSON(buf []byte) Result {
+	for _, b := range jsonBOMs {
+		if bytes.HasPrefix(buf, []byte(b.bom)) {
+			res := newResult(b.encoding, 1.0, "")
+			res.Source = SourceBOM
+			return res
+		}
+	}
+
+	if encoding := jsonPattern(buf); encoding != "" {
+		if encoding == consts.UTF8 && !validUTF8(buf) {
+			// the bytes are not valid in any JSON encoding
+			return newResult(consts.UTF8, 0.01, "")
+		}
+		res := newResult(encoding, 0.99, "")
+		res.Source = SourceJSON
+		return res
+	}
+
+	d := NewUniversalDetector(consts.AllLangFilter)
+	d.Extractors = nil
+	d.Feed(buf)
+	switch res := d.GetResult(); res.Encoding {
+	case consts.UTF8, consts.UTF8SIG, consts.UTF16Le, consts.UTF16Be, consts.UTF32Le, consts.UTF32Be:
+		return res
+	case consts.Ascii:
+		return newResult(consts.UTF8, res.Confidence, "")
+	}
+	return newResult(consts.UTF8, 0.01, "")
+}
+
+// jsonPattern returns the JSON encoding given by the zero bytes at the start
+// of buf, or "" if they match no pattern. A JSON text of a single character,
+// such as 1, is two bytes long in UTF-16.
+func jsonPattern(buf []byte) string {
+	switch {
+	case len(buf) >= 4:
+		zero := [4]bool{buf[0] == 0, buf[1] == 0, buf[2] == 0, buf[3] == 0}
+		switch zero {
+		case [4]bool{true, true, true, false}:
+			return consts.UTF32Be
+		case [4]bool{true, false, true, false}:
+			return consts.UTF16Be
+		case [4]bool{false, true, true, true}:
+			return consts.UTF32Le
+		case [4]bool{false, true, false, true}:
+			return consts.UTF16Le
+		case [4]bool{false, false, false, false}:
+			return consts.UTF8
+		}
+	case len(buf) == 2 && buf[0] == 0 && buf[1] != 0:
+		return consts.UTF16Be
+	case len(buf) == 2 && buf[0] != 0 && buf[1] == 0:
+		return consts.UTF16Le
+	}
+	return ""
+}
+
+// validUTF8 reports whether buf is valid UTF-8, allowing it to end in the
+// middle of a character.
+func validUTF8(buf []byte) bool {
+	for i := len(buf) - 1; i >= 0 && i >= len(buf)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(buf[i]) {
+			if !utf8.FullRune(buf[i:]) {
+				buf = buf[:i]
+			}
+			break
+		}
+	}
+	return utf8.Valid(buf)
+}
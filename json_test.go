@@ -0,0 +1,62 @@
+package chardet
+
+import (
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+)
+
+func TestDetectJSON(t *testing.T) {
+	const doc = `{"name": "Служба", "port": 8080, "tags": ["日志", "サービス"]}`
+
+	tests := []struct {
+		name     string
+		encoding encoding.Encoding
+		text     string
+		want     string
+		source   Source
+	}{
+		{"UTF-8", unicode.UTF8, doc, consts.UTF8, SourceJSON},
+		{"ASCII", unicode.UTF8, `{"port": 8080}`, consts.UTF8, SourceJSON},
+		{"UTF-16LE", unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), doc, consts.UTF16Le, SourceJSON},
+		{"UTF-16BE", unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), doc, consts.UTF16Be, SourceJSON},
+		{"UTF-32LE", utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM), doc, consts.UTF32Le, SourceJSON},
+		{"UTF-32BE", utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM), doc, consts.UTF32Be, SourceJSON},
+		{"UTF-16LE with BOM", unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), doc, consts.UTF16Le, SourceBOM},
+		{"UTF-16LE number", unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), "1", consts.UTF16Le, SourceJSON},
+		{"UTF-16BE number", unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), "7", consts.UTF16Be, SourceJSON},
+	}
+
+	for _, tt := range tests {
+		buf, err := tt.encoding.NewEncoder().Bytes([]byte(tt.text))
+		if err != nil {
+			t.Fatalf("failed to encode %s: %v", tt.name, err)
+		}
+
+		res := DetectJSON(buf)
+		if res.Charset != tt.want || res.Source != tt.source {
+			t.Errorf("DetectJSON(%s) = %+v, want %s from %s", tt.name, res, tt.want, tt.source)
+		}
+	}
+}
+
+func TestDetectJSONOnlyJSONEncodings(t *testing.T) {
+	tests := map[string][]byte{
+		"Windows-1251": []byte("{\"name\": \"\xd1\xeb\xf3\xe6\xe1\xe0 \xe7\xe0\xef\xf3\xf9\xe5\xed\xe0\"}"),
+		"short":        []byte("1"),
+		"truncated":    []byte("{\"name\": \"\xd0\xa1\xd0"),
+	}
+
+	for name, buf := range tests {
+		if res := DetectJSON(buf); res.Charset != consts.UTF8 {
+			t.Errorf("DetectJSON(%s) = %+v, want %s", name, res, consts.UTF8)
+		}
+	}
+
+	if res := DetectJSON(tests["truncated"]); res.Confidence < 0.5 {
+		t.Errorf("DetectJSON(truncated) = %+v, want confident UTF-8", res)
+	}
+}
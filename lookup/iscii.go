@@ -0,0 +1,336 @@
+package lookup
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// ISCII-91 control bytes.
+const (
+	isciiINV  = 0xD9 // invisible consonant
+	isciiHLT  = 0xE8 // halant (virama)
+	isciiNUKT = 0xE9 // nukta
+	isciiATR  = 0xEF // attribute, followed by a script or display selector
+	isciiEXT  = 0xF0 // extension, followed by a Vedic sign
+)
+
+const (
+	zwnj = 0x200C
+	zwj  = 0x200D
+)
+
+// isciiTable maps the ISCII-91 bytes 0xA0-0xFF to Devanagari. The other
+// Indic Unicode blocks follow the ISCII layout, so a character of another
+// script is found at the same offset from the start of its block. Zero marks
+// undefined and control bytes.
+var isciiTable = [96]rune{
+	0, 0x0901, 0x0902, 0x0903, 0x0905, 0x0906, 0x0907, 0x0908, // A0 - A7
+	0x0909, 0x090A, 0x090B, 0x090E, 0x090F, 0x0910, 0x090D, 0x0912, // A8 - AF
+	0x0913, 0x0914, 0x0911, 0x0915, 0x0916, 0x0917, 0x0918, 0x0919, // B0 - B7
+	0x091A, 0x091B, 0x091C, 0x091D, 0x091E, 0x091F, 0x0920, 0x0921, // B8 - BF
+	0x0922, 0x0923, 0x0924, 0x0925, 0x0926, 0x0927, 0x0928, 0x0929, // C0 - C7
+	0x092A, 0x092B, 0x092C, 0x092D, 0x092E, 0x092F, 0x095F, 0x0930, // C8 - CF
+	0x0931, 0x0932, 0x0933, 0x0934, 0x0935, 0x0936, 0x0937, 0x0938, // D0 - D7
+	0x0939, zwj, 0x093E, 0x093F, 0x0940, 0x0941, 0x0942, 0x0943, // D8 - DF
+	0x0946, 0x0947, 0x0948, 0x0945, 0x094A, 0x094B, 0x094C, 0x0949, // E0 - E7
+	0x094D, 0x093C, 0x0964, 0, 0, 0, 0, 0, // E8 - EF
+	0, 0x0966, 0x0967, 0x0968, 0x0969, 0x096A, 0x096B, 0x096C, // F0 - F7
+	0x096D, 0x096E, 0x096F, 0, 0, 0, 0, 0, // F8 - FF
+}
+
+// isciiNukta lists the characters written as a byte followed by the nukta.
+var isciiNukta = map[byte]rune{
+	0xA1: 0x0950, // om
+	0xA6: 0x090C, // vocalic l
+	0xA7: 0x0961, // vocalic ll
+	0xAA: 0x0960, // vocalic rr
+	0xDB: 0x0962, // vowel sign vocalic l
+	0xDC: 0x0963, // vowel sign vocalic ll
+	0xDF: 0x0944, // vowel sign vocalic rr
+	0xEA: 0x093D, // avagraha
+}
+
+// isciiScript identifies the Indic script an ISCII byte is read in.
+type isciiScript byte
+
+// ISCII script selectors, as they follow the ATR byte.
+const (
+	isciiDevanagari isciiScript = 0x42
+	isciiBengali    isciiScript = 0x43
+	isciiTamil      isciiScript = 0x44
+	isciiTelugu     isciiScript = 0x45
+	isciiAssamese   isciiScript = 0x46
+	isciiOriya      isciiScript = 0x47
+	isciiKannada    isciiScript = 0x48
+	isciiMalayalam  isciiScript = 0x49
+	isciiGujarati   isciiScript = 0x4A
+	isciiPunjabi    isciiScript = 0x4B
+)
+
+// block returns the first code point of the script's Unicode block.
+func (s isciiScript) block() rune {
+	switch s {
+	case isciiBengali, isciiAssamese:
+		return 0x0980
+	case isciiPunjabi:
+		return 0x0A00
+	case isciiGujarati:
+		return 0x0A80
+	case isciiOriya:
+		return 0x0B00
+	case isciiTamil:
+		return 0x0B80
+	case isciiTelugu:
+		return 0x0C00
+	case isciiKannada:
+		return 0x0C80
+	case isciiMalayalam:
+		return 0x0D00
+	default:
+		return 0x0900
+	}
+}
+
+// isciiScriptOf returns the script whose Unicode block contains r.
+func isciiScriptOf(r rune) (isciiScript, bool) {
+	switch r &^ 0x7F {
+	case 0x0900:
+		return isciiDevanagari, true
+	case 0x0980:
+		return isciiBengali, true
+	case 0x0A00:
+		return isciiPunjabi, true
+	case 0x0A80:
+		return isciiGujarati, true
+	case 0x0B00:
+		return isciiOriya, true
+	case 0x0B80:
+		return isciiTamil, true
+	case 0x0C00:
+		return isciiTelugu, true
+	case 0x0C80:
+		return isciiKannada, true
+	case 0x0D00:
+		return isciiMalayalam, true
+	}
+	return 0, false
+}
+
+// iscii is the stateful ISCII-91 encoding. The ATR byte switches scripts in
+// the middle of the text; script is the one in effect at the start.
+type iscii struct {
+	name   string
+	script isciiScript
+}
+
+var (
+	// ISCII is ISCII-91 starting in Devanagari.
+	ISCII encoding.Encoding = &iscii{name: "ISCII", script: isciiDevanagari}
+
+	// The Windows ISCII code pages only differ in the script they start in.
+	ISCIIBengali   encoding.Encoding = &iscii{name: "x-iscii-be", script: isciiBengali}
+	ISCIITamil     encoding.Encoding = &iscii{name: "x-iscii-ta", script: isciiTamil}
+	ISCIITelugu    encoding.Encoding = &iscii{name: "x-iscii-te", script: isciiTelugu}
+	ISCIIAssamese  encoding.Encoding = &iscii{name: "x-iscii-as", script: isciiAssamese}
+	ISCIIOriya     encoding.Encoding = &iscii{name: "x-iscii-or", script: isciiOriya}
+	ISCIIKannada   encoding.Encoding = &iscii{name: "x-iscii-ka", script: isciiKannada}
+	ISCIIMalayalam encoding.Encoding = &iscii{name: "x-iscii-ma", script: isciiMalayalam}
+	ISCIIGujarati  encoding.Encoding = &iscii{name: "x-iscii-gu", script: isciiGujarati}
+	ISCIIPunjabi   encoding.Encoding = &iscii{name: "x-iscii-pa", script: isciiPunjabi}
+)
+
+// NewDecoder implements the encoding.Encoding interface.
+func (e *iscii) NewDecoder() *encoding.Decoder {
+	d := &isciiDecoder{start: e.script}
+	d.Reset()
+	return &encoding.Decoder{Transformer: d}
+}
+
+// NewEncoder implements the encoding.Encoding interface.
+func (e *iscii) NewEncoder() *encoding.Encoder {
+	enc := &isciiEncoder{start: e.script}
+	enc.Reset()
+	return &encoding.Encoder{Transformer: enc}
+}
+
+// String returns the encoding name.
+func (e *iscii) String() string {
+	return e.name
+}
+
+type isciiDecoder struct {
+	start, script isciiScript
+}
+
+func (d *isciiDecoder) Reset() {
+	d.script = d.start
+}
+
+func (d *isciiDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		c := src[nSrc]
+		if c < utf8.RuneSelf {
+			if nDst >= len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			dst[nDst] = c
+			nDst++
+			nSrc++
+			continue
+		}
+
+		// every sequence is at most two bytes long
+		var next byte
+		hasNext := nSrc+1 < len(src)
+		if hasNext {
+			next = src[nSrc+1]
+		} else if !atEOF {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		var runes [2]rune
+		n, size := 1, 1
+		switch {
+		case c == isciiATR:
+			if hasNext {
+				size = 2
+				if isciiScript(next) >= isciiDevanagari && isciiScript(next) <= isciiPunjabi {
+					d.script = isciiScript(next)
+				}
+			}
+			// display attributes have no Unicode equivalent
+			n = 0
+		case c == isciiEXT:
+			if hasNext {
+				size = 2
+			}
+			runes[0] = utf8.RuneError
+		case c == isciiHLT && hasNext && next == isciiHLT:
+			runes[0], runes[1], n, size = d.char(c), zwnj, 2, 2
+		case c == isciiHLT && hasNext && next == isciiNUKT:
+			runes[0], runes[1], n, size = d.char(c), zwj, 2, 2
+		case hasNext && next == isciiNUKT && isciiNukta[c] != 0:
+			runes[0], size = d.shift(isciiNukta[c]), 2
+		default:
+			runes[0] = d.char(c)
+		}
+
+		need := 0
+		for _, r := range runes[:n] {
+			need += utf8.RuneLen(r)
+		}
+		if nDst+need > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		for _, r := range runes[:n] {
+			nDst += utf8.EncodeRune(dst[nDst:], r)
+		}
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// char decodes a single byte in the current script.
+func (d *isciiDecoder) char(c byte) rune {
+	if c < 0xA0 {
+		return utf8.RuneError
+	}
+	r := isciiTable[c-0xA0]
+	if r == 0 {
+		return utf8.RuneError
+	}
+	return d.shift(r)
+}
+
+// shift moves a Devanagari character into the block of the current script.
+func (d *isciiDecoder) shift(r rune) rune {
+	if r >= 0x0900 && r < 0x0980 {
+		return r - 0x0900 + d.script.block()
+	}
+	return r
+}
+
+type isciiEncoder struct {
+	start, script isciiScript
+	afterHalant   bool
+}
+
+func (e *isciiEncoder) Reset() {
+	e.script = e.start
+	e.afterHalant = false
+}
+
+// isciiReverse maps Devanagari characters and joiners back to ISCII bytes.
+var isciiReverse = func() map[rune][]byte {
+	m := make(map[rune][]byte, 128)
+	for i, r := range isciiTable {
+		if r != 0 && r != zwj {
+			m[r] = []byte{byte(0xA0 + i)}
+		}
+	}
+	for c, r := range isciiNukta {
+		m[r] = []byte{c, isciiNUKT}
+	}
+	// precomposed nukta consonants
+	for r, c := range map[rune]byte{0x0958: 0xB3, 0x0959: 0xB4, 0x095A: 0xB5, 0x095B: 0xBA, 0x095C: 0xBF, 0x095D: 0xC0, 0x095E: 0xC9} {
+		m[r] = []byte{c, isciiNUKT}
+	}
+	m[zwj] = []byte{isciiINV}
+	return m
+}()
+
+func (e *isciiEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		r, size := rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			if !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			r, size = utf8.DecodeRune(src[nSrc:])
+			if r == utf8.RuneError && size == 1 {
+				return nDst, nSrc, encoding.ErrInvalidUTF8
+			}
+		}
+
+		var out [4]byte
+		n := 0
+		switch script, ok := isciiScriptOf(r); {
+		case r < utf8.RuneSelf:
+			out[0], n = byte(r), 1
+		case ok:
+			if script != e.script && !(script == isciiBengali && e.script == isciiAssamese) {
+				out[0], out[1], n = isciiATR, byte(script), 2
+			}
+			b, found := isciiReverse[r-script.block()+0x0900]
+			if !found {
+				return nDst, nSrc, repertoireError(encoding.ASCIISub)
+			}
+			n += copy(out[n:], b)
+		case r == zwnj || r == zwj:
+			// joiners after a halant are written as a doubled halant or halant + nukta
+			if e.afterHalant {
+				out[0], n = isciiHLT, 1
+				if r == zwj {
+					out[0] = isciiNUKT
+				}
+			} else if r == zwj {
+				out[0], n = isciiINV, 1
+			}
+		default:
+			return nDst, nSrc, repertoireError(encoding.ASCIISub)
+		}
+
+		if nDst+n > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		if n >= 2 && out[0] == isciiATR {
+			e.script = isciiScript(out[1])
+		}
+		nDst += copy(dst[nDst:], out[:n])
+		nSrc += size
+		e.afterHalant = n > 0 && out[n-1] == isciiHLT && r != zwnj && r != zwj
+	}
+	return nDst, nSrc, nil
+}
@@ -36,6 +36,49 @@ func LookupEncoding(name string) (encoding.Encoding, error) {
 	case "maccyrillic", "x-mac-cyrillic":
 		return charmap.MacintoshCyrillic, nil
 
+	case "maccentraleurope", "x-mac-ce", "x-mac-centraleurroman":
+		return MacCentralEurope, nil
+	case "macicelandic", "x-mac-icelandic":
+		return MacIcelandic, nil
+	case "macgreek", "x-mac-greek":
+		return MacGreek, nil
+	case "macturkish", "x-mac-turkish":
+		return MacTurkish, nil
+	case "machebrew", "x-mac-hebrew":
+		return MacHebrew, nil
+	case "macarabic", "x-mac-arabic":
+		return MacArabic, nil
+
+	case "armscii-8", "armscii8":
+		return ARMSCII8, nil
+	case "georgian-ps", "georgianps":
+		return GeorgianPS, nil
+	case "georgian-academy", "georgianacademy":
+		return GeorgianAcademy, nil
+
+	case "iscii", "iscii-91", "x-iscii-de", "x-iscii91":
+		return ISCII, nil
+	case "x-iscii-be":
+		return ISCIIBengali, nil
+	case "x-iscii-ta":
+		return ISCIITamil, nil
+	case "x-iscii-te":
+		return ISCIITelugu, nil
+	case "x-iscii-as":
+		return ISCIIAssamese, nil
+	case "x-iscii-or":
+		return ISCIIOriya, nil
+	case "x-iscii-ka":
+		return ISCIIKannada, nil
+	case "x-iscii-ma":
+		return ISCIIMalayalam, nil
+	case "x-iscii-gu":
+		return ISCIIGujarati, nil
+	case "x-iscii-pa":
+		return ISCIIPunjabi, nil
+	case "tscii", "tscii-1.7":
+		return TSCII, nil
+
 	case "euc-tw",
 		"cp932", "ms932", "windows-932", "windows-31j",
 		"cp949", "ms949", "windows-949":
@@ -1,13 +1,28 @@
 package lookup
 
-import "testing"
+import (
+	"bytes"
+	"testing"
+)
 
 func TestLookupEncoding(t *testing.T) {
 	tests := map[string]bool{
-		"US-ASCII":  true,
-		"Shift_JIS": true,
-		"csGB2312":  true,
-		"cp932":     false, // Supported charset but no decoder available
+		"US-ASCII":         true,
+		"Shift_JIS":        true,
+		"csGB2312":         true,
+		"ARMSCII-8":        true,
+		"Georgian-PS":      true,
+		"Georgian-Academy": true,
+		"x-mac-ce":         true,
+		"x-mac-icelandic":  true,
+		"x-mac-turkish":    true,
+		"x-mac-greek":      true,
+		"x-mac-hebrew":     true,
+		"x-mac-arabic":     true,
+		"ISCII":            true,
+		"x-iscii-be":       true,
+		"TSCII":            true,
+		"cp932":            false, // Supported charset but no decoder available
 	}
 
 	for name, expectDecoder := range tests {
@@ -23,3 +38,79 @@ func TestLookupEncoding(t *testing.T) {
 		}
 	}
 }
+
+func TestCharmapRoundTrip(t *testing.T) {
+	tests := map[string]string{
+		"ARMSCII-8":        "Բոլոր մարդիկ ծնվում են ազատ և հավասար։",
+		"Georgian-PS":      "ყველა ადამიანი იბადება თავისუფალი.",
+		"Georgian-Academy": "ყველა ადამიანი იბადება თავისუფალი.",
+		"x-mac-ce":         "Všichni lidé rodí se svobodní.",
+		"x-mac-icelandic":  "Hver maður er borinn frjáls.",
+		"x-mac-turkish":    "Bütün insanlar hür doğarlar.",
+		"x-mac-greek":      "Όλοι οι άνθρωποι γεννιούνται ελεύθεροι.",
+		"x-mac-hebrew":     "כל בני האדם נולדו בני חורין.",
+		"x-mac-arabic":     "يولد جميع الناس أحرارا.",
+	}
+
+	for name, text := range tests {
+		enc, err := LookupEncoding(name)
+		if err != nil || enc == nil {
+			t.Fatalf("LookupEncoding(%s) = %v, %v", name, enc, err)
+		}
+
+		encoded, err := enc.NewEncoder().String(text)
+		if err != nil {
+			t.Fatalf("%s: encode failed: %v", name, err)
+		}
+		if len(encoded) != len([]rune(text)) {
+			t.Fatalf("%s: expected one byte per rune, got %d bytes", name, len(encoded))
+		}
+
+		decoded, err := enc.NewDecoder().String(encoded)
+		if err != nil {
+			t.Fatalf("%s: decode failed: %v", name, err)
+		}
+		if decoded != text {
+			t.Fatalf("%s: round trip mismatch: %q", name, decoded)
+		}
+	}
+}
+
+func TestIndicCodecs(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		encoded []byte
+	}{
+		// Devanagari, then Bengali after an ATR script selector
+		{"ISCII", "नमस्ते নমস্কার", []byte{0xC6, 0xCC, 0xD7, 0xE8, 0xC2, 0xE1, 0x20, 0xEF, 0x43, 0xC6, 0xCC, 0xD7, 0xE8, 0xB3, 0xDA, 0xCF}},
+		{"ISCII", "ॐ", []byte{0xA1, 0xE9}},
+		{"x-iscii-ta", "தமிழ்", []byte{0xC2, 0xCC, 0xDB, 0xD3, 0xE8}},
+		// vowel signs written before the consonant, as glibc's TSCII converter does
+		{"TSCII", "கொண்டு கோவில் கௌரவம்", []byte{0xA6, 0xB8, 0xA1, 0xF1, 0xCE, 0x20, 0xA7, 0xB8, 0xA1, 0xC5, 0xA2, 0xF8, 0x20, 0xA7, 0xB8, 0xAA, 0xC3, 0xC5, 0xF5}},
+		{"TSCII", "ஸ்ரீ க்ஷ", []byte{0x82, 0x20, 0x87}},
+	}
+
+	for _, tt := range tests {
+		enc, err := LookupEncoding(tt.name)
+		if err != nil || enc == nil {
+			t.Fatalf("LookupEncoding(%s) = %v, %v", tt.name, enc, err)
+		}
+
+		encoded, err := enc.NewEncoder().Bytes([]byte(tt.text))
+		if err != nil {
+			t.Fatalf("%s: encode failed: %v", tt.name, err)
+		}
+		if !bytes.Equal(encoded, tt.encoded) {
+			t.Errorf("%s: encode(%q) = % X, want % X", tt.name, tt.text, encoded, tt.encoded)
+		}
+
+		decoded, err := enc.NewDecoder().Bytes(tt.encoded)
+		if err != nil {
+			t.Fatalf("%s: decode failed: %v", tt.name, err)
+		}
+		if string(decoded) != tt.text {
+			t.Errorf("%s: decode = %q, want %q", tt.name, decoded, tt.text)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package lookup
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// singleByte is a single-byte encoding for charsets that golang.org/x/text does
+// not implement. Bytes map to exactly one rune; unmapped bytes decode to
+// U+FFFD.
+type singleByte struct {
+	name   string
+	decode [256]rune
+	encode map[rune]byte
+}
+
+func newSingleByte(name string, decode [256]rune) *singleByte {
+	m := &singleByte{
+		name:   name,
+		decode: decode,
+		encode: make(map[rune]byte, 256),
+	}
+	for i := len(decode) - 1; i >= 0; i-- {
+		if r := decode[i]; r != utf8.RuneError {
+			// prefer the lowest byte when several bytes decode to the same rune
+			m.encode[r] = byte(i)
+		}
+	}
+	return m
+}
+
+// NewDecoder implements the encoding.Encoding interface.
+func (m *singleByte) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: singleByteDecoder{charmap: m}}
+}
+
+// NewEncoder implements the encoding.Encoding interface.
+func (m *singleByte) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: singleByteEncoder{charmap: m}}
+}
+
+// String returns the encoding name.
+func (m *singleByte) String() string {
+	return m.name
+}
+
+type singleByteDecoder struct {
+	transform.NopResetter
+	charmap *singleByte
+}
+
+func (d singleByteDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for i, c := range src {
+		r := d.charmap.decode[c]
+		if nDst+utf8.RuneLen(r) > len(dst) {
+			return nDst, i, transform.ErrShortDst
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+	}
+	return nDst, len(src), nil
+}
+
+type singleByteEncoder struct {
+	transform.NopResetter
+	charmap *singleByte
+}
+
+// repertoireError reports a rune that the encoding cannot represent; it is
+// understood by encoding.ReplaceUnsupported.
+type repertoireError byte
+
+func (repertoireError) Error() string {
+	return "lookup: rune not supported by encoding"
+}
+
+func (r repertoireError) Replacement() byte {
+	return byte(r)
+}
+
+func (e singleByteEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if nDst >= len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+
+		r, size := rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			if !atEOF && !utf8.FullRune(src[nSrc:]) {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			r, size = utf8.DecodeRune(src[nSrc:])
+			if r == utf8.RuneError && size == 1 {
+				return nDst, nSrc, encoding.ErrInvalidUTF8
+			}
+		}
+
+		b, ok := e.charmap.encode[r]
+		if !ok {
+			return nDst, nSrc, repertoireError(encoding.ASCIISub)
+		}
+		dst[nDst] = b
+		nDst++
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// asciiTable returns a decoding table with bytes 0x00-0x7F mapped to ASCII and
+// the rest left undefined.
+func asciiTable() [256]rune {
+	var t [256]rune
+	for i := range t {
+		if i < utf8.RuneSelf {
+			t[i] = rune(i)
+		} else {
+			t[i] = utf8.RuneError
+		}
+	}
+	return t
+}
+
+// ARMSCII8 is the Armenian Standard Code for Information Interchange.
+var ARMSCII8 encoding.Encoding = newSingleByte("ARMSCII-8", armscii8Table())
+
+func armscii8Table() [256]rune {
+	t := asciiTable()
+	for i := 0x80; i < 0xA0; i++ {
+		t[i] = rune(i)
+	}
+	copy(t[0xA0:0xB2], []rune{
+		0x00A0, utf8.RuneError, 0x0587, 0x0589, 0x0029, 0x0028, 0x00BB, 0x00AB,
+		0x2014, 0x002E, 0x055D, 0x002C, 0x002D, 0x058A, 0x2026, 0x055C,
+		0x055B, 0x055E,
+	})
+	// capital and small letters alternate from 0xB2 to 0xFD
+	for i := rune(0); i < 38; i++ {
+		t[0xB2+2*i] = 0x0531 + i
+		t[0xB3+2*i] = 0x0561 + i
+	}
+	t[0xFE] = 0x055A
+	return t
+}
+
+// georgianTable returns the layout shared by Georgian-PS and Georgian-Academy:
+// Windows-1252 punctuation in 0x80-0x9F, Latin-1 elsewhere, and the 39 Georgian
+// letters starting at 0xC0.
+func georgianTable(letters []rune) [256]rune {
+	t := asciiTable()
+	copy(t[0x80:0xA0], []rune{
+		0x0080, 0x0081, 0x201A, 0x0192, 0x201E, 0x2026, 0x2020, 0x2021,
+		0x02C6, 0x2030, 0x0160, 0x2039, 0x0152, 0x008D, 0x008E, 0x008F,
+		0x0090, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014,
+		0x02DC, 0x2122, 0x0161, 0x203A, 0x0153, 0x009D, 0x009E, 0x0178,
+	})
+	for i := 0xA0; i < 0x100; i++ {
+		t[i] = rune(i)
+	}
+	copy(t[0xC0:], letters)
+	return t
+}
+
+// GeorgianPS is the Georgian Parliament standard encoding, which keeps the
+// archaic letters in their traditional alphabetical positions.
+var GeorgianPS encoding.Encoding = newSingleByte("Georgian-PS", georgianTable([]rune{
+	0x10D0, 0x10D1, 0x10D2, 0x10D3, 0x10D4, 0x10D5, 0x10D6, 0x10F1,
+	0x10D7, 0x10D8, 0x10D9, 0x10DA, 0x10DB, 0x10DC, 0x10F2, 0x10DD,
+	0x10DE, 0x10DF, 0x10E0, 0x10E1, 0x10E2, 0x10F3, 0x10E3, 0x10E4,
+	0x10E5, 0x10E6, 0x10E7, 0x10E8, 0x10E9, 0x10EA, 0x10EB, 0x10EC,
+	0x10ED, 0x10EE, 0x10F4, 0x10EF, 0x10F0, 0x10F5, 0x10F6,
+}))
+
+// GeorgianAcademy is the Georgian Academy of Sciences encoding, which follows
+// the Unicode order of the Georgian block.
+var GeorgianAcademy encoding.Encoding = newSingleByte("Georgian-Academy", georgianTable([]rune{
+	0x10D0, 0x10D1, 0x10D2, 0x10D3, 0x10D4, 0x10D5, 0x10D6, 0x10D7,
+	0x10D8, 0x10D9, 0x10DA, 0x10DB, 0x10DC, 0x10DD, 0x10DE, 0x10DF,
+	0x10E0, 0x10E1, 0x10E2, 0x10E3, 0x10E4, 0x10E5, 0x10E6, 0x10E7,
+	0x10E8, 0x10E9, 0x10EA, 0x10EB, 0x10EC, 0x10ED, 0x10EE, 0x10EF,
+	0x10F0, 0x10F1, 0x10F2, 0x10F3, 0x10F4, 0x10F5, 0x10F6,
+}))
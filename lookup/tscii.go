@@ -0,0 +1,226 @@
+package lookup
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// tsciiTable maps the TSCII 1.7 bytes 0x80-0xFF to Unicode. TSCII is a glyph
+// encoding: a byte may stand for a whole syllable, and the vowel signs ெ, ே
+// and ை are written before the consonant they follow in Unicode.
+var tsciiTable = [128]string{
+	"\u0BE6", "\u0BE7", "\u0BB8\u0BCD\u0BB0\u0BC0", "\u0B9C", // 80 - 83
+	"\u0BB7", "\u0BB8", "\u0BB9", "\u0B95\u0BCD\u0BB7", // 84 - 87
+	"\u0B9C\u0BCD", "\u0BB7\u0BCD", "\u0BB8\u0BCD", "\u0BB9\u0BCD", // 88 - 8B
+	"\u0B95\u0BCD\u0BB7\u0BCD", "\u0BE8", "\u0BE9", "\u0BEA", // 8C - 8F
+	"\u0BEB", "\u2018", "\u2019", "\u201C", // 90 - 93
+	"\u201D", "\u0BEC", "\u0BED", "\u0BEE", // 94 - 97
+	"\u0BEF", "\u0B99\u0BC1", "\u0B9E\u0BC1", "\u0B99\u0BC2", // 98 - 9B
+	"\u0B9E\u0BC2", "\u0BF0", "\u0BF1", "\u0BF2", // 9C - 9F
+	"", "\u0BBE", "\u0BBF", "\u0BC0", // A0 - A3
+	"\u0BC1", "\u0BC2", "\u0BC6", "\u0BC7", // A4 - A7
+	"\u0BC8", "\u00A9", "\u0BD7", "\u0B85", // A8 - AB
+	"\u0B86", "\u0B87", "\u0B88", "\u0B89", // AC - AF
+	"\u0B8A", "\u0B8E", "\u0B8F", "\u0B90", // B0 - B3
+	"\u0B92", "\u0B93", "\u0B94", "\u0B83", // B4 - B7
+	"\u0B95", "\u0B99", "\u0B9A", "\u0B9E", // B8 - BB
+	"\u0B9F", "\u0BA3", "\u0BA4", "\u0BA8", // BC - BF
+	"\u0BAA", "\u0BAE", "\u0BAF", "\u0BB0", // C0 - C3
+	"\u0BB2", "\u0BB5", "\u0BB4", "\u0BB3", // C4 - C7
+	"\u0BB1", "\u0BA9", "\u0B9F\u0BBF", "\u0B9F\u0BC0", // C8 - CB
+	"\u0B95\u0BC1", "\u0B9A\u0BC1", "\u0B9F\u0BC1", "\u0BA3\u0BC1", // CC - CF
+	"\u0BA4\u0BC1", "\u0BA8\u0BC1", "\u0BAA\u0BC1", "\u0BAE\u0BC1", // D0 - D3
+	"\u0BAF\u0BC1", "\u0BB0\u0BC1", "\u0BB2\u0BC1", "\u0BB5\u0BC1", // D4 - D7
+	"\u0BB4\u0BC1", "\u0BB3\u0BC1", "\u0BB1\u0BC1", "\u0BA9\u0BC1", // D8 - DB
+	"\u0B95\u0BC2", "\u0B9A\u0BC2", "\u0B9F\u0BC2", "\u0BA3\u0BC2", // DC - DF
+	"\u0BA4\u0BC2", "\u0BA8\u0BC2", "\u0BAA\u0BC2", "\u0BAE\u0BC2", // E0 - E3
+	"\u0BAF\u0BC2", "\u0BB0\u0BC2", "\u0BB2\u0BC2", "\u0BB5\u0BC2", // E4 - E7
+	"\u0BB4\u0BC2", "\u0BB3\u0BC2", "\u0BB1\u0BC2", "\u0BA9\u0BC2", // E8 - EB
+	"\u0B95\u0BCD", "\u0B99\u0BCD", "\u0B9A\u0BCD", "\u0B9E\u0BCD", // EC - EF
+	"\u0B9F\u0BCD", "\u0BA3\u0BCD", "\u0BA4\u0BCD", "\u0BA8\u0BCD", // F0 - F3
+	"\u0BAA\u0BCD", "\u0BAE\u0BCD", "\u0BAF\u0BCD", "\u0BB0\u0BCD", // F4 - F7
+	"\u0BB2\u0BCD", "\u0BB5\u0BCD", "\u0BB4\u0BCD", "\u0BB3\u0BCD", // F8 - FB
+	"\u0BB1\u0BCD", "\u0BA9\u0BCD", "\u0B87", "", // FC - FF
+
+}
+
+// TSCII vowel signs stored before their consonant.
+const (
+	tsciiSignE  = 0xA6
+	tsciiSignEE = 0xA7
+	tsciiSignAI = 0xA8
+	tsciiSignAA = 0xA1
+	tsciiAuMark = 0xAA
+)
+
+// tsciiConsonant reports whether b is a consonant that a prefix vowel sign can
+// attach to.
+func tsciiConsonant(b byte) bool {
+	return (b >= 0x83 && b <= 0x87) || (b >= 0xB8 && b <= 0xC9)
+}
+
+// tsciiReverse maps the syllables of tsciiTable back to their bytes.
+var tsciiReverse = func() map[string]byte {
+	m := make(map[string]byte, len(tsciiTable))
+	for i := len(tsciiTable) - 1; i >= 0; i-- {
+		if s := tsciiTable[i]; s != "" {
+			// prefer the lowest byte when several bytes decode to the same text
+			m[s] = byte(0x80 + i)
+		}
+	}
+	return m
+}()
+
+type tscii struct{}
+
+// TSCII is the Tamil TSCII 1.7 encoding.
+var TSCII encoding.Encoding = tscii{}
+
+// NewDecoder implements the encoding.Encoding interface.
+func (tscii) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: tsciiDecoder{}}
+}
+
+// NewEncoder implements the encoding.Encoding interface.
+func (tscii) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: tsciiEncoder{}}
+}
+
+// String returns the encoding name.
+func (tscii) String() string {
+	return "TSCII"
+}
+
+type tsciiDecoder struct {
+	transform.NopResetter
+}
+
+func (tsciiDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		c := src[nSrc]
+		if c < utf8.RuneSelf {
+			if nDst >= len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			dst[nDst] = c
+			nDst++
+			nSrc++
+			continue
+		}
+
+		text, size := tsciiTable[c-0x80], 1
+		if c == tsciiSignE || c == tsciiSignEE || c == tsciiSignAI {
+			// a prefix sign needs the consonant and a possible second sign
+			if !atEOF && len(src)-nSrc < 3 {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			if nSrc+1 < len(src) && tsciiConsonant(src[nSrc+1]) {
+				text, size = tsciiTable[src[nSrc+1]-0x80]+text, 2
+				if nSrc+2 < len(src) {
+					switch {
+					case c == tsciiSignE && src[nSrc+2] == tsciiSignAA:
+						text, size = tsciiTable[src[nSrc+1]-0x80]+"\u0BCA", 3
+					case c == tsciiSignEE && src[nSrc+2] == tsciiSignAA:
+						text, size = tsciiTable[src[nSrc+1]-0x80]+"\u0BCB", 3
+					case c == tsciiSignEE && src[nSrc+2] == tsciiAuMark:
+						text, size = tsciiTable[src[nSrc+1]-0x80]+"\u0BCC", 3
+					}
+				}
+			}
+		}
+		if text == "" {
+			text = "\uFFFD"
+		}
+
+		if nDst+len(text) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], text)
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+type tsciiEncoder struct {
+	transform.NopResetter
+}
+
+// tsciiPrefix lists the Unicode vowel signs that TSCII writes around the
+// consonant, as the bytes before and after it.
+var tsciiPrefix = map[rune][2]byte{
+	0x0BC6: {tsciiSignE, 0},
+	0x0BC7: {tsciiSignEE, 0},
+	0x0BC8: {tsciiSignAI, 0},
+	0x0BCA: {tsciiSignE, tsciiSignAA},
+	0x0BCB: {tsciiSignEE, tsciiSignAA},
+	0x0BCC: {tsciiSignEE, tsciiAuMark},
+}
+
+// tsciiMaxRunes is the longest syllable in tsciiTable, in runes.
+const tsciiMaxRunes = 4
+
+func (tsciiEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if c := src[nSrc]; c < utf8.RuneSelf {
+			if nDst >= len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			dst[nDst] = c
+			nDst++
+			nSrc++
+			continue
+		}
+		// make sure the longest syllable and a following vowel sign are buffered
+		if !atEOF && len(src)-nSrc < (tsciiMaxRunes+1)*utf8.UTFMax {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+
+		var ends [tsciiMaxRunes + 1]int
+		n, end := 0, nSrc
+		for n < len(ends) && end < len(src) {
+			r, size := utf8.DecodeRune(src[end:])
+			if r == utf8.RuneError && size == 1 {
+				if n == 0 {
+					return nDst, nSrc, encoding.ErrInvalidUTF8
+				}
+				break
+			}
+			end += size
+			ends[n] = end
+			n++
+		}
+
+		// the longest syllable starting here
+		var b byte
+		k := min(n, tsciiMaxRunes)
+		for ; k > 0; k-- {
+			if v, ok := tsciiReverse[string(src[nSrc:ends[k-1]])]; ok {
+				b = v
+				break
+			}
+		}
+		if k == 0 {
+			return nDst, nSrc, repertoireError(encoding.ASCIISub)
+		}
+
+		out, consumed := []byte{b}, ends[k-1]
+		if tsciiConsonant(b) && k < n {
+			r, _ := utf8.DecodeRune(src[ends[k-1]:])
+			if sign, ok := tsciiPrefix[r]; ok {
+				out, consumed = []byte{sign[0], b}, ends[k]
+				if sign[1] != 0 {
+					out = append(out, sign[1])
+				}
+			}
+		}
+
+		if nDst+len(out) > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], out)
+		nSrc = consumed
+	}
+	return nDst, nSrc, nil
+}
@@ -0,0 +1,22 @@
+package chardet
+
+import (
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/probe"
+)
+
+// Observer is told of the progress of detection, as it happens. The methods
+// are called from Feed and GetResult, and must not call back into the
+// detector.
+type Observer interface {
+	// OnProbeStateChange is called when a probe, a member of a group or an
+	// escape sequence of EscCharSetProbe finds its charset or is ruled out
+	probe.Observer
+	// OnInputStateChange is called when the input turns out to hold escapes
+	// or high bytes, which brings other probes into play
+	OnInputStateChange(from, to consts.InputState)
+	// OnDone is called once detection ends, with the result GetResult
+	// returns. Feed ends it as soon as the result is certain, so that no
+	// more data needs to be buffered.
+	OnDone(result Result)
+}
@@ -0,0 +1,74 @@
+package chardet
+
+import (
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/probe"
+)
+
+type recorder struct {
+	changes []probe.StateChange
+	inputs  []consts.InputState
+	done    []Result
+}
+
+func (r *recorder) OnProbeStateChange(change probe.StateChange) {
+	r.changes = append(r.changes, change)
+}
+
+func (r *recorder) OnInputStateChange(from, to consts.InputState) {
+	r.inputs = append(r.inputs, from, to)
+}
+
+func (r *recorder) OnDone(result Result) {
+	r.done = append(r.done, result)
+}
+
+func (r *recorder) changed(group, charset string, state consts.ProbingState) bool {
+	for _, c := range r.changes {
+		if c.Group == group && c.Charset == charset && c.State == state {
+			return true
+		}
+	}
+	return false
+}
+
+func TestObserver(t *testing.T) {
+	var r recorder
+	d, err := New(WithObserver(&r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Feed([]byte("Caf\xe9 cr\xe8me br\xfbl\xe9e, "))
+	if len(r.inputs) != 2 || r.inputs[0] != consts.PureAsciiInputState || r.inputs[1] != consts.HighByteInputState {
+		t.Errorf("OnInputStateChange calls = %v, want from ASCII to high bytes", r.inputs)
+	}
+	if !r.changed("MBCSGroupProbe", consts.UTF8, consts.NotMeProbingState) {
+		t.Errorf("OnProbeStateChange calls = %+v, want UTF-8 ruled out in MBCSGroupProbe", r.changes)
+	}
+	if len(r.done) != 0 {
+		t.Errorf("OnDone calls before GetResult = %+v, want none", r.done)
+	}
+	res := d.GetResult()
+	d.GetResult()
+	if len(r.done) != 1 || r.done[0] != res {
+		t.Errorf("OnDone calls = %+v, want one with %+v", r.done, res)
+	}
+
+	// a certain result ends detection in Feed
+	r = recorder{}
+	d, _ = New(WithObserver(&r))
+	d.Feed([]byte("Hello \x1b$B$3$s$K$A$O\x1b(B"))
+	if len(r.done) != 1 || r.done[0].Charset != consts.ISO2022JP {
+		t.Fatalf("OnDone calls = %+v, want one with %s", r.done, consts.ISO2022JP)
+	}
+	if !r.changed("EscCharSetProbe", consts.ISO2022KR, consts.NotMeProbingState) ||
+		!r.changed("EscCharSetProbe", consts.ISO2022JP, consts.FoundItProbingState) ||
+		!r.changed("", consts.ISO2022JP, consts.FoundItProbingState) {
+		t.Errorf("OnProbeStateChange calls = %+v, want ISO-2022-KR ruled out and ISO-2022-JP found", r.changes)
+	}
+	if res := d.GetResult(); len(r.done) != 1 || r.done[0] != res {
+		t.Errorf("OnDone calls after GetResult = %+v, want one with %+v", r.done, res)
+	}
+}
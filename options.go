@@ -0,0 +1,252 @@
+package chardet
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/probe"
+)
+
+// config holds the settings chosen by options.
+type config struct {
+	filter           consts.LangFilter
+	minimumThreshold float64
+	setMinimum       bool
+	tuning           probe.Tuning
+	preDecode        bool
+	keepEscapes      bool
+	charsets         []string
+	excludeCharsets  []string
+	hints            []Hint
+	logger           *slog.Logger
+	observer         Observer
+
+	// err is the first invalid setting
+	err error
+}
+
+// Option configures detection.
+type Option func(*config)
+
+func newConfig(opts []Option) config {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// invalid records the first invalid setting.
+func (c *config) invalid(format string, args ...any) {
+	if c.err == nil {
+		c.err = fmt.Errorf("chardet: "+format, args...)
+	}
+}
+
+// detector returns a detector with the settings of c.
+func (c *config) detector() *UniversalDetector {
+	d := NewUniversalDetector(c.filter)
+	if c.setMinimum {
+		d.MinimumThreshold = c.minimumThreshold
+	}
+	d.Tuning = c.tuning
+	d.Charsets = c.charsets
+	d.ExcludeCharsets = c.excludeCharsets
+	d.Hints = c.hints
+	d.PreDecode = c.preDecode
+	d.KeepTerminalEscapes = c.keepEscapes
+	d.Logger = c.logger
+	d.Observer = c.observer
+	return d
+}
+
+// New returns a detector configured by opts. The settings that are not given
+// keep the defaults of NewUniversalDetector.
+func New(opts ...Option) (*UniversalDetector, error) {
+	c := newConfig(opts)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.detector(), nil
+}
+
+// DetectWith detects the encoding of buf with a detector configured by opts.
+func DetectWith(buf []byte, opts ...Option) (Result, error) {
+	d, err := New(opts...)
+	if err != nil {
+		return Result{}, err
+	}
+	d.Feed(buf)
+	return d.GetResult(), nil
+}
+
+// WithLanguageFilter restricts detection to the encodings of the languages in
+// filter. The default is consts.AllLangFilter.
+func WithLanguageFilter(filter consts.LangFilter) Option {
+	return func(c *config) {
+		c.filter = filter
+	}
+}
+
+// WithMinimumThreshold sets the confidence a probe must exceed for its answer
+// to be reported, 0.20 by default. It must be at least 0 and below 1.
+func WithMinimumThreshold(threshold float64) Option {
+	return func(c *config) {
+		if threshold < 0 || threshold >= 1 {
+			c.invalid("minimum threshold %v out of range [0, 1)", threshold)
+			return
+		}
+		c.minimumThreshold = threshold
+		c.setMinimum = true
+	}
+}
+
+// WithShortcutThreshold sets the confidence at which a multi-byte probe,
+// UTF-8 included, stops early with its answer, 0.95 by default. It must be
+// above 0 and at most 1. Lower values answer sooner but more often wrongly.
+func WithShortcutThreshold(threshold float64) Option {
+	return func(c *config) {
+		if threshold <= 0 || threshold > 1 {
+			c.invalid("shortcut threshold %v out of range (0, 1]", threshold)
+			return
+		}
+		c.tuning.ShortcutThreshold = threshold
+	}
+}
+
+// WithSampleSize sets how many of the most frequent letters of a language the
+// single-byte probes count letter pairs of, 64 by default. It must be between
+// 1 and 64, the size of the language models.
+func WithSampleSize(size int) Option {
+	return func(c *config) {
+		if size < 1 || size > 64 {
+			c.invalid("sample size %d out of range [1, 64]", size)
+			return
+		}
+		c.tuning.SampleSize = size
+	}
+}
+
+// WithSingleByteShortcuts sets the confidences at which a single-byte probe
+// stops early: with its answer above positive, 0.95 by default, and giving up
+// below negative, 0.05 by default. Both must be within (0, 1] and negative
+// below positive.
+func WithSingleByteShortcuts(positive, negative float64) Option {
+	return func(c *config) {
+		switch {
+		case positive <= 0 || positive > 1 || negative <= 0 || negative > 1:
+			c.invalid("single-byte shortcut thresholds %v and %v out of range (0, 1]", positive, negative)
+			return
+		case negative >= positive:
+			c.invalid("negative shortcut threshold %v is not below positive shortcut threshold %v", negative, positive)
+			return
+		}
+		c.tuning.PositiveShortcutThreshold = positive
+		c.tuning.NegativeShortcutThreshold = negative
+	}
+}
+
+// WithEnoughDataThreshold sets how many characters a multi-byte probe must see
+// before it may stop early, 1024 by default. It must be positive.
+func WithEnoughDataThreshold(chars int) Option {
+	return func(c *config) {
+		if chars <= 0 {
+			c.invalid("enough data threshold %d is not positive", chars)
+			return
+		}
+		c.tuning.EnoughDataThreshold = chars
+	}
+}
+
+// WithMinCharsForDetection sets how many characters the BOM-less UTF-16 and
+// UTF-32 probe scans before it may answer, 4 by default. It must be positive.
+func WithMinCharsForDetection(chars int) Option {
+	return func(c *config) {
+		if chars <= 0 {
+			c.invalid("minimum characters for detection %d is not positive", chars)
+			return
+		}
+		c.tuning.MinCharsForDetection = chars
+	}
+}
+
+// WithPreDecoding unwraps quoted-printable, percent-encoded and numeric entity
+// text that is otherwise ASCII before detection, as UniversalDetector.PreDecode
+// does.
+func WithPreDecoding() Option {
+	return func(c *config) {
+		c.preDecode = true
+	}
+}
+
+// WithTerminalEscapes keeps the CSI and OSC escape sequences of coloured
+// terminal output, which are removed by default, as
+// UniversalDetector.KeepTerminalEscapes does.
+func WithTerminalEscapes() Option {
+	return func(c *config) {
+		c.keepEscapes = true
+	}
+}
+
+// WithCharsets restricts detection to the named charsets, as
+// UniversalDetector.Charsets does. Only their probes are run. Pure ASCII text
+// is reported as the first of them that reads it the same when ASCII is not
+// named.
+func WithCharsets(names ...string) Option {
+	return func(c *config) {
+		if slices.Contains(names, "") {
+			c.invalid("empty charset name")
+			return
+		}
+		c.charsets = append(c.charsets, names...)
+	}
+}
+
+// WithoutCharsets rules out the named charsets, as
+// UniversalDetector.ExcludeCharsets does.
+func WithoutCharsets(names ...string) Option {
+	return func(c *config) {
+		if slices.Contains(names, "") {
+			c.invalid("empty charset name")
+			return
+		}
+		c.excludeCharsets = append(c.excludeCharsets, names...)
+	}
+}
+
+// WithHints adds expectations about the encoding that tip the balance between
+// probes that are about as confident, as UniversalDetector.Hints does. Each
+// hint needs a charset or a language and a weight above -1.
+func WithHints(hints ...Hint) Option {
+	return func(c *config) {
+		for _, h := range hints {
+			switch {
+			case h.Charset == "" && h.Language == "":
+				c.invalid("hint without charset or language")
+				return
+			case h.Weight <= -1:
+				c.invalid("hint weight %v not above -1", h.Weight)
+				return
+			}
+		}
+		c.hints = append(c.hints, hints...)
+	}
+}
+
+// WithLogger sends debug events about the detection to l, as
+// UniversalDetector.Logger does.
+func WithLogger(l *slog.Logger) Option {
+	return func(c *config) {
+		c.logger = l
+	}
+}
+
+// WithObserver has o told of the progress of detection, as
+// UniversalDetector.Observer is.
+func WithObserver(o Observer) Option {
+	return func(c *config) {
+		c.observer = o
+	}
+}
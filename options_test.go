@@ -0,0 +1,191 @@
+package chardet
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/probe"
+)
+
+func TestNewInvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{"minimum threshold", WithMinimumThreshold(1)},
+		{"shortcut threshold", WithShortcutThreshold(0)},
+		{"sample size", WithSampleSize(65)},
+		{"single-byte shortcuts", WithSingleByteShortcuts(0.5, 0.6)},
+		{"enough data", WithEnoughDataThreshold(-1)},
+		{"minimum characters", WithMinCharsForDetection(0)},
+	}
+
+	for _, tt := range tests {
+		if d, err := New(tt.opt); err == nil || d != nil {
+			t.Errorf("New(%s) = %v, %v, want an error", tt.name, d, err)
+		}
+		if _, err := DetectWith([]byte("text"), tt.opt); err == nil || !strings.HasPrefix(err.Error(), "chardet: ") {
+			t.Errorf("DetectWith(%s) error = %v, want a chardet error", tt.name, err)
+		}
+	}
+}
+
+func TestNewTuning(t *testing.T) {
+	d, err := New(
+		WithMinimumThreshold(0.5),
+		WithShortcutThreshold(0.9),
+		WithSampleSize(32),
+		WithSingleByteShortcuts(0.9, 0.1),
+		WithMinCharsForDetection(16),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.MinimumThreshold != 0.5 {
+		t.Errorf("MinimumThreshold = %v, want 0.5", d.MinimumThreshold)
+	}
+
+	d.Feed([]byte("\xcf\xf0\xe8\xe2\xe5\xf2"))
+	if d.utf1632Probe.MinCharsForDetection != 16 {
+		t.Errorf("UTF1632Probe.MinCharsForDetection = %v, want 16", d.utf1632Probe.MinCharsForDetection)
+	}
+
+	var sbcs, mbcs int
+	for _, p := range d.leafProbes() {
+		switch p := p.(type) {
+		case *probe.SingleByteCharSetProbe:
+			sbcs++
+			if p.SampleSize != 32 || p.SBEnoughRelThreshold != 256 ||
+				p.PositiveShortcutThreshold != 0.9 || p.NegativeShortcutThreshold != 0.1 {
+				t.Errorf("%s probe not tuned: %+v", p.CharSetName(), p)
+			}
+		case *probe.SJISProbe:
+			mbcs++
+			if p.ShortcutThreshold != 0.9 {
+				t.Errorf("SJISProbe.ShortcutThreshold = %v, want 0.9", p.ShortcutThreshold)
+			}
+		case *probe.UTF8Probe:
+			mbcs++
+			if p.ShortcutThreshold != 0.9 {
+				t.Errorf("UTF8Probe.ShortcutThreshold = %v, want 0.9", p.ShortcutThreshold)
+			}
+		}
+	}
+	if sbcs == 0 || mbcs != 2 {
+		t.Errorf("found %d single-byte and %d multi-byte probes", sbcs, mbcs)
+	}
+}
+
+func TestDetectWith(t *testing.T) {
+	// Russian in windows-1251, which the CJK filter leaves no probe for
+	text := []byte("\xcf\xf0\xe8\xe2\xe5\xf2, \xea\xe0\xea \xe4\xe5\xeb\xe0? \xdd\xf2\xee \xef\xf0\xee\xf1\xf2\xee\xe9 \xf2\xe5\xea\xf1\xf2.")
+
+	res, err := DetectWith(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Language != "Russian" {
+		t.Errorf("DetectWith() = %+v, want Russian", res)
+	}
+
+	res, err = DetectWith(text, WithLanguageFilter(consts.CjkLangFilter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Language == "Russian" {
+		t.Errorf("DetectWith(CJK) = %+v, want no Russian", res)
+	}
+}
+
+func TestLanguageFilter(t *testing.T) {
+	russian, err := os.ReadFile("test/testdata/windows-1251-russian/_chromium_windows-1251_with_no_encoding_specified.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hebrew, err := os.ReadFile("test/testdata/windows-1255-hebrew/_ude_he1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		buf       []byte
+		filter    consts.LangFilter
+		languages consts.LangFilter
+		language  string
+	}{
+		{"Cyrillic", russian, consts.CyrillicLangFilter, consts.CyrillicLangFilter, consts.Russian},
+		{"no Hebrew or Thai", hebrew, consts.AllLangFilter &^ (consts.HebrewLangFilter | consts.ThaiLangFilter),
+			consts.AllLangFilter &^ (consts.HebrewLangFilter | consts.ThaiLangFilter), ""},
+		{"Hebrew", hebrew, consts.HebrewLangFilter, consts.HebrewLangFilter, consts.Hebrew},
+		{"legacy", russian, consts.NonCjkLangFilter, consts.NonCjkLangFilter, consts.Russian},
+		// the value NonCjkLangFilter had when it was a single bit
+		{"legacy bit", russian, consts.LangFilter(32), consts.NonCjkLangFilter, consts.Russian},
+	}
+
+	for _, tt := range tests {
+		d, err := New(WithLanguageFilter(tt.filter))
+		if err != nil {
+			t.Fatal(err)
+		}
+		d.Feed(tt.buf)
+		res := d.GetResult()
+		if tt.language != "" && res.Language != tt.language {
+			t.Errorf("Detect(%s) = %+v, want %s", tt.name, res, tt.language)
+		}
+		if res.Language != "" && consts.LanguageFilter(res.Language)&tt.languages == 0 {
+			t.Errorf("Detect(%s) = %+v, a language filtered out", tt.name, res)
+		}
+
+		for _, p := range d.charsetProbes {
+			switch p := p.(type) {
+			case *probe.MBCSGroupProbe:
+			case *probe.SBCSGroupProbe:
+				for _, m := range p.Probes() {
+					if _, ok := m.(*probe.IsciiProbe); !ok && consts.LanguageFilter(m.Language())&tt.languages == 0 {
+						t.Errorf("Detect(%s) ran the %s probe of %s", tt.name, m.CharSetName(), m.Language())
+					}
+				}
+			default:
+				if tt.languages&consts.LatinLangFilter == 0 {
+					t.Errorf("Detect(%s) ran the %s probe", tt.name, p.CharSetName())
+				}
+			}
+		}
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	var log bytes.Buffer
+	d, err := New(WithLogger(slog.New(slog.NewTextHandler(&log, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Feed([]byte("Caf\xe9 cr\xe8me br\xfbl\xe9e, "))
+	d.Feed([]byte("\x93d\xe9licieux\x94 disait-il \xe0 la cantine"))
+	res := d.GetResult()
+
+	for _, want := range []string{
+		`msg="input state changed" from=ASCII to="high bytes" offset=0`,
+		`msg="probe ruled out" probe=UTF8Probe charset=UTF-8`,
+		`reason="illegal byte 0x20"`,
+		`msg="charset renamed for Windows bytes" from=ISO-8859-1 to=Windows-1252`,
+		`msg="detection done" charset=` + res.Charset,
+	} {
+		if !strings.Contains(log.String(), want) {
+			t.Errorf("log = %s, want %s", log.String(), want)
+		}
+	}
+
+	log.Reset()
+	d, _ = New(WithLogger(slog.New(slog.NewTextHandler(&log, nil))))
+	d.Feed([]byte("Caf\xe9"))
+	d.GetResult()
+	if log.Len() > 0 {
+		t.Errorf("log at info level = %s, want nothing", log.String())
+	}
+}
@@ -0,0 +1,166 @@
+package chardet
+
+import (
+	"bytes"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Wrapping names an ASCII transfer encoding that text was unwrapped from
+// before detection.
+type Wrapping string
+
+const (
+	// QuotedPrintable is the =E4=F6 encoding of MIME
+	QuotedPrintable Wrapping = "quoted-printable"
+	// PercentEncoding is the %C3%A9 encoding of URLs
+	PercentEncoding Wrapping = "percent-encoding"
+	// NumericEntities are HTML and XML character references such as &#233;
+	// and &#x4E2D;
+	NumericEntities Wrapping = "numeric-entities"
+)
+
+// maxPreDecode is how many bytes of ASCII input are kept for pre-decoding.
+const maxPreDecode = 64 << 10
+
+// minWrapped is how many escapes must be found before text is taken to be
+// wrapped, so that a stray "=E9" or "%20" is left alone.
+const minWrapped = 2
+
+// unwrap undoes the transfer encoding that most of the escapes in buf belong
+// to, provided one of them stands for a non-ASCII character.
+func unwrap(buf []byte) ([]byte, Wrapping) {
+	var (
+		best     []byte
+		wrapping Wrapping
+		most     int
+	)
+	for _, w := range []struct {
+		wrapping Wrapping
+		decode   func([]byte) ([]byte, int)
+	}{
+		{QuotedPrintable, decodeQuotedPrintable},
+		{PercentEncoding, decodePercent},
+		{NumericEntities, decodeEntities},
+	} {
+		out, escapes := w.decode(buf)
+		if escapes >= minWrapped && escapes > most && HighByteDetector(out) {
+			best, wrapping, most = out, w.wrapping, escapes
+		}
+	}
+	return best, wrapping
+}
+
+// decodeQuotedPrintable decodes =XX escapes and removes soft line breaks. It
+// returns the decoded text and the number of escapes.
+func decodeQuotedPrintable(buf []byte) ([]byte, int) {
+	out := make([]byte, 0, len(buf))
+	escapes := 0
+	for i := 0; i < len(buf); i++ {
+		c := buf[i]
+		switch {
+		case c != '=':
+		case i+2 < len(buf) && isHex(buf[i+1]) && isHex(buf[i+2]):
+			out = append(out, unhex(buf[i+1])<<4|unhex(buf[i+2]))
+			escapes++
+			i += 2
+			continue
+		case bytes.HasPrefix(buf[i+1:], []byte("\r\n")):
+			i += 2
+			continue
+		case bytes.HasPrefix(buf[i+1:], []byte("\n")):
+			i++
+			continue
+		}
+		out = append(out, c)
+	}
+	return out, escapes
+}
+
+// decodePercent decodes %XX escapes. It returns the decoded text and the
+// number of escapes.
+func decodePercent(buf []byte) ([]byte, int) {
+	out := make([]byte, 0, len(buf))
+	escapes := 0
+	for i := 0; i < len(buf); i++ {
+		if c := buf[i]; c == '%' && i+2 < len(buf) && isHex(buf[i+1]) && isHex(buf[i+2]) {
+			out = append(out, unhex(buf[i+1])<<4|unhex(buf[i+2]))
+			escapes++
+			i += 2
+			continue
+		}
+		out = append(out, buf[i])
+	}
+	return out, escapes
+}
+
+// decodeEntities decodes decimal and hexadecimal character references. When
+// none is above U+00FF, the references are taken as bytes: text escaped byte
+// by byte from a legacy charset looks like Latin-1, and the probes tell which
+// charset the bytes belong to. Otherwise the characters are written in UTF-8.
+// It returns the decoded text and the number of references.
+func decodeEntities(buf []byte) ([]byte, int) {
+	type reference struct {
+		start, end int
+		r          rune
+	}
+
+	var (
+		refs  []reference
+		wide  bool
+		start int
+	)
+	for {
+		i := bytes.Index(buf[start:], []byte("&#"))
+		if i < 0 {
+			break
+		}
+		i += start
+		start = i + 2
+
+		digits, base := buf[i+2:], 10
+		if len(digits) > 0 && (digits[0] == 'x' || digits[0] == 'X') {
+			digits, base = digits[1:], 16
+		}
+		end := bytes.IndexByte(digits, ';')
+		if end <= 0 || end > 8 {
+			continue
+		}
+		n, err := strconv.ParseUint(string(digits[:end]), base, 32)
+		if err != nil || !utf8.ValidRune(rune(n)) {
+			continue
+		}
+
+		ref := reference{start: i, end: len(buf) - len(digits) + end + 1, r: rune(n)}
+		refs = append(refs, ref)
+		wide = wide || ref.r > 0xFF
+		start = ref.end
+	}
+
+	out := make([]byte, 0, len(buf))
+	last := 0
+	for _, ref := range refs {
+		out = append(out, buf[last:ref.start]...)
+		if wide {
+			out = utf8.AppendRune(out, ref.r)
+		} else {
+			out = append(out, byte(ref.r))
+		}
+		last = ref.end
+	}
+	return append(out, buf[last:]...), len(refs)
+}
+
+func isHex(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'A' && c <= 'F') || (c >= 'a' && c <= 'f')
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c >= 'a':
+		return c - 'a' + 10
+	case c >= 'A':
+		return c - 'A' + 10
+	}
+	return c - '0'
+}
@@ -0,0 +1,67 @@
+package chardet
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/lookup"
+)
+
+func TestPreDecode(t *testing.T) {
+	const russian = "Служба запущена и ожидает подключений на порту 8080. Пользователь вошёл в систему."
+
+	cp1251, err := lookup.LookupEncoding("windows-1251")
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := cp1251.NewEncoder().Bytes([]byte(russian))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var qp, bytewise, entities strings.Builder
+	for _, b := range raw {
+		fmt.Fprintf(&qp, "=%02X", b)
+		fmt.Fprintf(&bytewise, "&#%d;", b)
+	}
+	for _, r := range russian {
+		fmt.Fprintf(&entities, "&#x%X;", r)
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		charset  string
+		wrapping Wrapping
+	}{
+		{"quoted-printable", qp.String(), consts.Windows1251, QuotedPrintable},
+		{"percent-encoding", url.QueryEscape(russian), consts.UTF8, PercentEncoding},
+		{"entities of bytes", "<p>" + bytewise.String() + "</p>", consts.Windows1251, NumericEntities},
+		{"entities of characters", "<p>" + entities.String() + "</p>", consts.UTF8, NumericEntities},
+		{"plain ASCII", "a=b&c=d %20 &#60;", consts.CanonicalCharset(consts.Ascii), ""},
+	}
+
+	for _, tt := range tests {
+		d := NewUniversalDetector(consts.AllLangFilter)
+		d.PreDecode = true
+		d.Feed([]byte(tt.text))
+		if res := d.GetResult(); res.Charset != tt.charset || res.Wrapping != tt.wrapping {
+			t.Errorf("%s: GetResult() = %+v, want %s unwrapped from %q", tt.name, res, tt.charset, tt.wrapping)
+		}
+
+		// without PreDecode the wrapped text is plain ASCII
+		if res := Detect([]byte(tt.text)); res.Wrapping != "" || res.Encoding != consts.Ascii {
+			t.Errorf("%s: Detect() = %+v, want ASCII", tt.name, res)
+		}
+	}
+}
+
+func TestDecodeQuotedPrintable(t *testing.T) {
+	got, escapes := decodeQuotedPrintable([]byte("caf=E9 au=\r\n lait=\n, a=b"))
+	if string(got) != "caf\xe9 au lait, a=b" || escapes != 1 {
+		t.Errorf("decodeQuotedPrintable() = %q, %d", got, escapes)
+	}
+}
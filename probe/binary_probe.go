@@ -0,0 +1,246 @@
+package probe
+
+import (
+	"bytes"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+// binaryMagic lists the signatures that open common binary file formats.
+var binaryMagic = []struct {
+	offset int
+	magic  string
+	format string
+}{
+	{0, "\x89PNG\r\n\x1a\n", "PNG image"},
+	{0, "GIF87a", "GIF image"},
+	{0, "GIF89a", "GIF image"},
+	{0, "\xFF\xD8\xFF", "JPEG image"},
+	{0, "II*\x00", "TIFF image"},
+	{0, "MM\x00*", "TIFF image"},
+	{0, "RIFF", "RIFF container"},
+	{0, "OggS\x00", "Ogg container"},
+	{0, "fLaC", "FLAC audio"},
+	{4, "ftyp", "ISO media"},
+	{0, "%PDF-", "PDF document"},
+	{0, "PK\x03\x04", "ZIP archive"},
+	{0, "PK\x05\x06", "ZIP archive"},
+	{0, "PK\x07\x08", "ZIP archive"},
+	{0, "Rar!\x1a\x07", "RAR archive"},
+	{0, "7z\xBC\xAF\x27\x1C", "7-Zip archive"},
+	{0, "\x1F\x8B\x08", "gzip data"},
+	{0, "BZh", "bzip2 data"},
+	{0, "\xFD7zXZ\x00", "xz data"},
+	{0, "\x28\xB5\x2F\xFD", "Zstandard data"},
+	{0, "\x7FELF", "ELF executable"},
+	{0, "\xFE\xED\xFA\xCE", "Mach-O executable"},
+	{0, "\xFE\xED\xFA\xCF", "Mach-O executable"},
+	{0, "\xCE\xFA\xED\xFE", "Mach-O executable"},
+	{0, "\xCF\xFA\xED\xFE", "Mach-O executable"},
+	{0, "\xCA\xFE\xBA\xBE", "Java class or Mach-O universal binary"},
+	{0, "\x00asm\x01\x00\x00\x00", "WebAssembly module"},
+	{0, "SQLite format 3\x00", "SQLite database"},
+}
+
+// binaryMagicHeader checks more of the header of the formats whose magic
+// number is short enough to start a text.
+var binaryMagicHeader = map[string]func(head []byte) bool{
+	"RIFF": riffForm,
+	"BZh":  bzip2Block,
+}
+
+// riffForm reports whether a RIFF header names a known form type.
+func riffForm(head []byte) bool {
+	if len(head) < 12 {
+		return false
+	}
+	switch string(head[8:12]) {
+	case "WAVE", "AVI ", "WEBP", "RMID", "CDXA", "ACON":
+		return true
+	}
+	return false
+}
+
+// bzip2Block reports whether a bzip2 header goes on with a block size and the
+// magic number of a block or of the end of the stream.
+func bzip2Block(head []byte) bool {
+	if len(head) < 10 || head[3] < '1' || head[3] > '9' {
+		return false
+	}
+	return string(head[4:10]) == "1AY&SY" || string(head[4:10]) == "\x17rE8P\x90"
+}
+
+// BinaryProbe tells binary data from text by the magic number of well-known
+// formats, the density of NUL bytes and the ratio of control characters.
+// UTF-16 and UTF-32 text is full of NULs too, so it must only be consulted
+// once the UTF-16/32 probes have given up. Text too short for them is still
+// told by its code units: every other byte is zero, or the high byte of the
+// Unicode block of the script.
+type BinaryProbe struct {
+	CharSetProbe
+
+	// the ratio of NUL bytes above which the data is binary
+	MaxNulRatio float64
+	// the ratio of other control characters above which the data is binary
+	MaxControlRatio float64
+	// the length from which a run of NUL bytes is padding
+	MinPadding int
+
+	head     []byte
+	total    int
+	nuls     int
+	padding  int
+	controls int
+	reason   string
+
+	// length counts the bytes fed and high holds, for the bytes at even and
+	// odd offsets, the value other than zero they all have: noHigh if none
+	// was seen yet, mixedHigh if several were
+	length int
+	high   [2]int
+}
+
+const (
+	noHigh    = 0
+	mixedHigh = -1
+)
+
+func NewBinaryProbe() *BinaryProbe {
+	p := &BinaryProbe{
+		CharSetProbe: NewCharSetProbe(consts.UnknownLangFilter),
+
+		MaxNulRatio:     0.01,
+		MaxControlRatio: 0.1,
+		MinPadding:      64,
+	}
+	p.Reset()
+	return p
+}
+
+func (b *BinaryProbe) Reset() {
+	b.CharSetProbe.Reset()
+	b.head = nil
+	b.total = 0
+	b.nuls = 0
+	b.padding = 0
+	b.controls = 0
+	b.reason = ""
+	b.length = 0
+	b.high = [2]int{noHigh, noHigh}
+}
+
+func (b *BinaryProbe) CharSetName() string {
+	return ""
+}
+
+func (b *BinaryProbe) Language() string {
+	return ""
+}
+
+func (b *BinaryProbe) Feed(buf []byte) consts.ProbingState {
+	if b.state != consts.DetectingProbingState {
+		return b.state
+	}
+
+	// the longest signature ends within the first 16 bytes
+	if len(b.head) < 16 {
+		b.head = append(b.head, buf[:min(len(buf), 16-len(b.head))]...)
+		for _, m := range binaryMagic {
+			if len(b.head) >= m.offset && bytes.HasPrefix(b.head[m.offset:], []byte(m.magic)) && b.header(m.magic) {
+				b.reason = m.format + " signature"
+				b.decide(consts.FoundItProbingState, m.offset-b.offset, "%s", b.reason)
+				return b.state
+			}
+		}
+	}
+
+	// text padded with NULs, as preallocating writers and fixed-size
+	// records leave it, is not binary: only the NULs between other bytes in
+	// runs shorter than MinPadding are counted
+	for _, c := range buf {
+		if h := &b.high[b.length%2]; c != 0x00 && *h != int(c) {
+			if *h == noHigh {
+				*h = int(c)
+			} else {
+				*h = mixedHigh
+			}
+		}
+		b.length++
+
+		if c == 0x00 {
+			b.padding++
+			continue
+		}
+		if b.total > 0 && b.padding < b.MinPadding {
+			b.nuls += b.padding
+			b.total += b.padding
+		}
+		b.padding = 0
+		b.total++
+		if (c < 0x20 && !isTextControl(c)) || c == 0x7F {
+			b.controls++
+		}
+	}
+	return b.state
+}
+
+// header reports whether the rest of the header matches the format opened by
+// magic, as far as binaryMagicHeader knows it.
+func (b *BinaryProbe) header(magic string) bool {
+	more, ok := binaryMagicHeader[magic]
+	return !ok || more(b.head)
+}
+
+// isTextControl reports whether c is a control character found in text: the
+// whitespace controls, the form feed, the ESC, SO and SI of ISO-2022 and the
+// DOS end-of-file marker.
+func isTextControl(c byte) bool {
+	switch c {
+	case '\t', '\n', '\v', '\f', '\r', 0x0E, 0x0F, 0x1A, 0x1B:
+		return true
+	}
+	return false
+}
+
+// Binary reports whether the data is binary and why.
+func (b *BinaryProbe) Binary() (bool, string) {
+	switch {
+	case b.state == consts.FoundItProbingState:
+		return true, b.reason
+	case b.total == 0, b.codeUnits():
+		return false, ""
+	case float64(b.nuls) > float64(b.total)*b.MaxNulRatio:
+		return true, "NUL bytes"
+	case float64(b.controls) > float64(b.total)*b.MaxControlRatio:
+		return true, "control characters"
+	}
+	return false, ""
+}
+
+// codeUnits reports whether the data reads as UTF-16 code units of a single
+// Unicode block: the bytes at either even or odd offsets are all zero or all
+// the same high byte, and the text of the Latin block has no control
+// characters.
+func (b *BinaryProbe) codeUnits() bool {
+	if b.length < 4 {
+		return false
+	}
+	for _, h := range b.high {
+		if h == noHigh && b.controls == 0 || h > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *BinaryProbe) GetConfidence() float64 {
+	if binary, _ := b.Binary(); !binary {
+		return 0.01
+	}
+	if b.state == consts.FoundItProbingState {
+		return 0.99
+	}
+	// the more non-text bytes, the surer the guess
+	ratio := float64(b.nuls+b.controls) / float64(b.total)
+	return min(0.5+ratio, 0.99)
+}
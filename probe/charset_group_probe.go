@@ -1,6 +1,8 @@
 package probe
 
 import (
+	"log/slog"
+
 	"github.com/wlynxg/chardet/consts"
 )
 
@@ -11,6 +13,10 @@ type CharSetGroupProbe struct {
 	activeNum      int
 	bestGuessProbe Probe
 	probes         []Probe
+	logger         *slog.Logger
+	observer       Observer
+	// name is the type of the group, as reported to observer
+	name string
 }
 
 func NewCharSetGroupProbe(filter consts.LangFilter, probes []Probe) CharSetGroupProbe {
@@ -72,13 +78,17 @@ func (c *CharSetGroupProbe) Feed(buf []byte) consts.ProbingState {
 		switch state {
 		case consts.FoundItProbingState:
 			c.bestGuessProbe = probe
-			c.state = consts.FoundItProbingState
+			c.decide(consts.FoundItProbingState, c.memberOffset(probe, len(buf)), "%s found it", probe.CharSetName())
+			LogVerdict(c.logger, probe)
+			c.notify(probe)
 			return c.state
 		case consts.NotMeProbingState:
+			LogVerdict(c.logger, probe)
+			c.notify(probe)
 			probe.SetActive(false)
 			c.activeNum--
 			if c.activeNum <= 0 {
-				c.state = consts.NotMeProbingState
+				c.decide(consts.NotMeProbingState, c.memberOffset(probe, len(buf)), "every probe of the group ruled out")
 				return c.state
 			}
 		default:
@@ -124,3 +134,17 @@ func (c *CharSetGroupProbe) GetConfidence() float64 {
 func (c *CharSetGroupProbe) Probes() []Probe {
 	return c.probes
 }
+
+// Keep removes the probes of the group that keep rejects and returns how many
+// are left.
+func (c *CharSetGroupProbe) Keep(keep func(Probe) bool) int {
+	probes := c.probes[:0]
+	for _, probe := range c.probes {
+		if probe != nil && keep(probe) {
+			probes = append(probes, probe)
+		}
+	}
+	c.probes = probes
+	c.Reset()
+	return len(probes)
+}
@@ -9,9 +9,11 @@ import (
 type CharSetProbe struct {
 	ShortcutThreshold float64
 
-	active bool
-	state  consts.ProbingState
-	filter consts.LangFilter
+	active  bool
+	state   consts.ProbingState
+	filter  consts.LangFilter
+	offset  int
+	verdict Verdict
 }
 
 func NewCharSetProbe(filter consts.LangFilter) CharSetProbe {
@@ -27,6 +29,8 @@ func NewCharSetProbe(filter consts.LangFilter) CharSetProbe {
 
 func (p *CharSetProbe) Reset() {
 	p.state = consts.DetectingProbingState
+	p.offset = 0
+	p.verdict = Verdict{}
 }
 
 func (p *CharSetProbe) SetActive(state bool) {
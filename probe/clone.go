@@ -0,0 +1,268 @@
+package probe
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/wlynxg/chardet/cda"
+)
+
+// cloneable is implemented by the probes that can be copied.
+type cloneable interface {
+	clone(c *copier) Probe
+}
+
+// copier deep-copies probes. It remembers the copies made, so that probes
+// referring to one another, as the Hebrew probe and the probes it names, refer
+// to one another's copies. The copies share the models and tables of the
+// probes, which are never written.
+type copier struct {
+	copies map[Probe]Probe
+	err    error
+}
+
+// Clone returns a deep copy of p that goes on independently of it.
+func Clone(p Probe) (Probe, error) {
+	c := copier{copies: map[Probe]Probe{}}
+	q := c.probe(p)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return q, nil
+}
+
+func (c *copier) probe(p Probe) Probe {
+	if p == nil {
+		return nil
+	}
+	if q, ok := c.copies[p]; ok {
+		return q
+	}
+	s, ok := p.(cloneable)
+	if !ok {
+		if c.err == nil {
+			c.err = fmt.Errorf("probe: cannot clone %T", p)
+		}
+		return nil
+	}
+	return s.clone(c)
+}
+
+// add records q as the copy of p before the probes p refers to are copied.
+func (c *copier) add(p, q Probe) {
+	c.copies[p] = q
+}
+
+func (c *CodingStateMachine) clone() *CodingStateMachine {
+	n := *c
+	return &n
+}
+
+// cloneProbes replaces the probes of a group just copied by their copies.
+func (g *CharSetGroupProbe) cloneProbes(c *copier) {
+	probes := make([]Probe, len(g.probes))
+	for i, p := range g.probes {
+		probes[i] = c.probe(p)
+	}
+	g.probes = probes
+	g.bestGuessProbe = c.probe(g.bestGuessProbe)
+}
+
+func (g *CharSetGroupProbe) clone(c *copier) Probe {
+	n := *g
+	c.add(g, &n)
+	n.cloneProbes(c)
+	return &n
+}
+
+func (m *MBCSGroupProbe) clone(c *copier) Probe {
+	n := *m
+	c.add(m, &n)
+	n.cloneProbes(c)
+	return &n
+}
+
+func (s *SBCSGroupProbe) clone(c *copier) Probe {
+	n := *s
+	c.add(s, &n)
+	n.cloneProbes(c)
+	return &n
+}
+
+// cloneAnalyzers replaces the coding state machine and the analyser of a
+// multi-byte probe just copied by copies of their own.
+func (m *MultiByteCharSetProbe) cloneAnalyzers() {
+	m.codingSM = m.codingSM.clone()
+	m.distributionAnalyzer = m.distributionAnalyzer.Clone()
+}
+
+func (m *MultiByteCharSetProbe) clone(c *copier) Probe {
+	n := *m
+	c.add(m, &n)
+	n.cloneAnalyzers()
+	return &n
+}
+
+func (b *Big5Probe) clone(c *copier) Probe {
+	n := *b
+	c.add(b, &n)
+	n.cloneAnalyzers()
+	return &n
+}
+
+func (p *CP949Probe) clone(c *copier) Probe {
+	n := *p
+	c.add(p, &n)
+	n.cloneAnalyzers()
+	return &n
+}
+
+func (e *EUCKRProbe) clone(c *copier) Probe {
+	n := *e
+	c.add(e, &n)
+	n.cloneAnalyzers()
+	return &n
+}
+
+func (e *EUCTWProbe) clone(c *copier) Probe {
+	n := *e
+	c.add(e, &n)
+	n.cloneAnalyzers()
+	return &n
+}
+
+func (g *GB2312Probe) clone(c *copier) Probe {
+	n := *g
+	c.add(g, &n)
+	n.cloneAnalyzers()
+	return &n
+}
+
+func (j *JOHABProbe) clone(c *copier) Probe {
+	n := *j
+	c.add(j, &n)
+	n.cloneAnalyzers()
+	return &n
+}
+
+func (s *SJISProbe) clone(c *copier) Probe {
+	n := *s
+	c.add(s, &n)
+	n.cloneAnalyzers()
+	n.contextAnalyzer = s.contextAnalyzer.Clone()
+	return &n
+}
+
+func (e *EUCJPProbe) clone(c *copier) Probe {
+	n := *e
+	c.add(e, &n)
+	n.cloneAnalyzers()
+	n.contextAnalyzer = e.contextAnalyzer.Clone()
+	return &n
+}
+
+func (u *UTF8Probe) clone(c *copier) Probe {
+	n := *u
+	c.add(u, &n)
+	n.codingSM = u.codingSM.clone()
+	return &n
+}
+
+func (s *SingleByteCharSetProbe) clone(c *copier) Probe {
+	n := *s
+	c.add(s, &n)
+	n.seqCounters = slices.Clone(s.seqCounters)
+	n.nameProbe = c.probe(s.nameProbe)
+	return &n
+}
+
+func (h *HebrewProbe) clone(c *copier) Probe {
+	n := *h
+	c.add(h, &n)
+	n.logicalProbe = c.probe(h.logicalProbe)
+	n.visualProbe = c.probe(h.visualProbe)
+	return &n
+}
+
+func (l *Latin1Probe) clone(c *copier) Probe {
+	n := *l
+	c.add(l, &n)
+	n.freqCounter = slices.Clone(l.freqCounter)
+	return &n
+}
+
+func (m *MacRomanProbe) clone(c *copier) Probe {
+	n := *m
+	c.add(m, &n)
+	n.freqCounter = slices.Clone(m.freqCounter)
+	return &n
+}
+
+func (m *MacLatinProbe) clone(c *copier) Probe {
+	n := *m
+	c.add(m, &n)
+	roman := *m.MacRomanProbe
+	roman.freqCounter = slices.Clone(roman.freqCounter)
+	n.MacRomanProbe = &roman
+	return &n
+}
+
+func (m *MacCharSetProbe) clone(c *copier) Probe {
+	n := *m
+	c.add(m, &n)
+	n.Probe = c.probe(m.Probe)
+	return &n
+}
+
+func (i *IsciiProbe) clone(c *copier) Probe {
+	n := *i
+	c.add(i, &n)
+	n.scriptLetters = maps.Clone(i.scriptLetters)
+	return &n
+}
+
+func (t *TsciiProbe) clone(c *copier) Probe {
+	n := *t
+	c.add(t, &n)
+	return &n
+}
+
+func (h *hzValidator) clone() *hzValidator {
+	n := *h
+	n.analysis = h.analysis.Clone().(*cda.GB2312DistributionAnalysis)
+	return &n
+}
+
+func (e *EscCharSetProbe) clone(c *copier) Probe {
+	n := *e
+	c.add(e, &n)
+	n.codingSM = make([]*CodingStateMachine, len(e.codingSM))
+	for i, sm := range e.codingSM {
+		n.codingSM[i] = sm.clone()
+	}
+	n.ruledOut = slices.Clone(e.ruledOut)
+	if e.hz != nil {
+		n.hz = e.hz.clone()
+	}
+	return &n
+}
+
+func (u *UTF1632Probe) clone(c *copier) Probe {
+	n := *u
+	c.add(u, &n)
+	return &n
+}
+
+func (u *UTF16BlockProbe) clone(c *copier) Probe {
+	n := *u
+	c.add(u, &n)
+	return &n
+}
+
+func (b *BinaryProbe) clone(c *copier) Probe {
+	n := *b
+	c.add(b, &n)
+	n.head = slices.Clone(b.head)
+	return &n
+}
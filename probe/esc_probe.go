@@ -1,6 +1,9 @@
 package probe
 
 import (
+	"fmt"
+	"log/slog"
+
 	"github.com/wlynxg/chardet/consts"
 )
 
@@ -12,6 +15,14 @@ type EscCharSetProbe struct {
 	detectedLanguage string
 
 	codingSM []*CodingStateMachine
+	// why each state machine stopped, if it did
+	ruledOut []Verdict
+	logger   *slog.Logger
+	observer Observer
+
+	// HZ is only reported once the text in GB mode proves to be Chinese
+	hz     *hzValidator
+	hzSeen bool
 }
 
 func NewEscCharSetProbe(filter consts.LangFilter) *EscCharSetProbe {
@@ -27,6 +38,7 @@ func NewEscCharSetProbe(filter consts.LangFilter) *EscCharSetProbe {
 		probe.codingSM = append(probe.codingSM,
 			NewCodingStateMachine(HzSmModel()),
 			NewCodingStateMachine(Iso2022cnSmModel()))
+		probe.hz = newHzValidator()
 	}
 
 	if probe.filter&consts.JapaneseLangFilter != 0 {
@@ -50,10 +62,15 @@ func (e *EscCharSetProbe) Reset() {
 		model.Reset()
 	}
 
-	e.state = consts.DetectingProbingState
+	e.CharSetProbe.Reset()
 	e.activeSmCount = len(e.codingSM)
+	e.ruledOut = make([]Verdict, len(e.codingSM))
 	e.detectedCharset = ""
 	e.detectedLanguage = ""
+	e.hzSeen = false
+	if e.hz != nil {
+		e.hz.reset()
+	}
 }
 
 func (e *EscCharSetProbe) GetConfidence() float64 {
@@ -73,8 +90,12 @@ func (e *EscCharSetProbe) Language() string {
 }
 
 func (e *EscCharSetProbe) Feed(buf []byte) consts.ProbingState {
-	for _, b := range buf {
-		for _, machine := range e.codingSM {
+	for i, b := range buf {
+		if e.hz != nil {
+			e.hz.feed(b)
+		}
+
+		for j, machine := range e.codingSM {
 			if machine == nil || !machine.Active {
 				continue
 			}
@@ -84,18 +105,42 @@ func (e *EscCharSetProbe) Feed(buf []byte) consts.ProbingState {
 			switch codingState {
 			case consts.ErrorMachineState:
 				machine.Active = false
+				e.ruledOut[j] = Verdict{Offset: e.offset + i, Reason: fmt.Sprintf("illegal byte 0x%02X", b)}
+				if e.logger != nil {
+					e.logger.Debug("escape sequence ruled out", "charset", machine.CodingStateMachine(),
+						"offset", e.ruledOut[j].Offset, "reason", e.ruledOut[j].Reason)
+				}
+				e.notify(machine, consts.NotMeProbingState, e.ruledOut[j])
 				e.activeSmCount--
 				if e.activeSmCount <= 0 {
-					e.state = consts.NotMeProbingState
+					e.decide(consts.NotMeProbingState, i, "illegal byte 0x%02X for every escape sequence", b)
 					return e.state
 				}
 			case consts.ItsMeMachineState:
-				e.state = consts.FoundItProbingState
+				if machine.CodingStateMachine() == consts.HzGB2312 {
+					e.hzSeen = true
+					continue
+				}
+				if e.state != consts.FoundItProbingState || e.detectedCharset != machine.CodingStateMachine() {
+					e.decide(consts.FoundItProbingState, i, "escape sequence of %s", machine.CodingStateMachine())
+					e.notify(machine, consts.FoundItProbingState, e.verdict)
+				}
 				e.detectedCharset = machine.CodingStateMachine()
 				e.detectedLanguage = machine.Language()
 			default:
 			}
 		}
 	}
+
+	if e.state == consts.DetectingProbingState && e.hzSeen && e.hz.plausible() {
+		e.decide(consts.FoundItProbingState, len(buf), "HZ escapes around plausible GB2312 text")
+		for _, machine := range e.codingSM {
+			if machine != nil && machine.CodingStateMachine() == consts.HzGB2312 {
+				e.notify(machine, consts.FoundItProbingState, e.verdict)
+			}
+		}
+		e.detectedCharset = consts.HzGB2312
+		e.detectedLanguage = consts.Chinese
+	}
 	return e.state
 }
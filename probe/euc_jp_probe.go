@@ -36,10 +36,10 @@ loop:
 		codingState := e.codingSM.NextState(b)
 		switch codingState {
 		case consts.ErrorMachineState:
-			e.state = consts.NotMeProbingState
+			e.decide(consts.NotMeProbingState, i, "illegal byte 0x%02X", b)
 			break loop
 		case consts.ItsMeMachineState:
-			e.state = consts.FoundItProbingState
+			e.decide(consts.FoundItProbingState, i, "byte 0x%02X ends a sequence only %s has", b, e.CharSetName())
 			break loop
 		case consts.StartMachineState:
 			charLen := e.codingSM.CurrentCharLength()
@@ -59,7 +59,7 @@ loop:
 	if e.state == consts.DetectingProbingState &&
 		e.contextAnalyzer.GotEnoughData() &&
 		(e.GetConfidence() > e.ShortcutThreshold) {
-		e.state = consts.FoundItProbingState
+		e.decide(consts.FoundItProbingState, len(buf), "confidence %.2f above shortcut threshold %.2f", e.GetConfidence(), e.ShortcutThreshold)
 	}
 	return e.state
 }
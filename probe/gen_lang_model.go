@@ -0,0 +1,347 @@
+//go:build ignore
+
+// gen_lang_model trains the language model of a single-byte probe on a corpus
+// and writes it, with the CharToOrderMap of each charset of the language, as
+// a lang_*_model.go file. It follows the procedure of the script that made the
+// models ported from Python chardet:
+//
+//   - the letters of the script are ranked by how often they appear, upper
+//     case letters sharing the order of their lower case;
+//   - the pairs of consecutive letters among the 64 most frequent are counted,
+//     and the most frequent eighth of the possible pairs are positive (3),
+//     the next eighth likely (2), the others seen unlikely (1) and those never
+//     seen negative (0), which is 512 pairs each for a sample of 64 letters;
+//   - TypicalPositiveRatio is the share of the positive pairs among all pairs.
+//
+// The corpus is made of UTF-8 text files and of gettext message catalogs
+// (.mo), of which the translations are read.
+//
+// Usage:
+//
+//	go run gen_lang_model.go -lang Armenian -script Armenian -var armenian \
+//		-charset ARMSCII-8,Armscii8,NewArmscii8ArmenianModel \
+//		-source "a description of the corpus" -o lang_armenian_model.go corpus...
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/wlynxg/chardet/lookup"
+)
+
+// sampleSize is the number of letters the language model covers.
+const sampleSize = 64
+
+type charset struct {
+	label, constant, constructor string
+	table                        [256]rune // utf8.RuneError for undefined bytes
+}
+
+type charsets []*charset
+
+func (c *charsets) String() string { return "" }
+
+func (c *charsets) Set(v string) error {
+	f := strings.Split(v, ",")
+	if len(f) != 3 {
+		return fmt.Errorf("want label,constant,constructor, got %q", v)
+	}
+	enc, err := lookup.LookupEncoding(f[0])
+	if err != nil || enc == nil {
+		return fmt.Errorf("no decoder for %s", f[0])
+	}
+	cs := &charset{label: f[0], constant: f[1], constructor: f[2]}
+	for b := 0; b < 256; b++ {
+		cs.table[b] = utf8.RuneError
+		if s, err := enc.NewDecoder().Bytes([]byte{byte(b)}); err == nil {
+			if r, n := utf8.DecodeRune(s); n == len(s) {
+				cs.table[b] = r
+			}
+		}
+	}
+	*c = append(*c, cs)
+	return nil
+}
+
+func main() {
+	var (
+		lang     = flag.String("lang", "", "language, as named by consts")
+		script   = flag.String("script", "", "Unicode script of the letters")
+		name     = flag.String("var", "", "prefix of the language model variable")
+		source   = flag.String("source", "", "description of the corpus")
+		out      = flag.String("o", "", "output file")
+		charsets charsets
+	)
+	flag.Var(&charsets, "charset", "label,constant,constructor of a charset of the language")
+	flag.Parse()
+
+	table := unicode.Scripts[*script]
+	if *lang == "" || table == nil || *name == "" || *out == "" || len(charsets) == 0 || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// the letters of the script that a charset encodes
+	letters := map[rune]bool{}
+	for _, cs := range charsets {
+		for _, r := range cs.table {
+			if unicode.Is(table, r) && unicode.IsLetter(r) && !unicode.Is(unicode.Lm, r) {
+				letters[unicode.ToLower(r)] = true
+			}
+		}
+	}
+
+	var corpus []string
+	for _, path := range flag.Args() {
+		texts, err := read(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		corpus = append(corpus, texts...)
+	}
+
+	freqs := map[rune]int{}
+	total := 0
+	for _, text := range corpus {
+		for _, r := range text {
+			if r = unicode.ToLower(r); letters[r] {
+				freqs[r]++
+				total++
+			}
+		}
+	}
+
+	ranked := make([]rune, 0, len(letters))
+	for r := range letters {
+		ranked = append(ranked, r)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if freqs[ranked[i]] != freqs[ranked[j]] {
+			return freqs[ranked[i]] > freqs[ranked[j]]
+		}
+		return ranked[i] < ranked[j]
+	})
+	order := map[rune]int{}
+	for i, r := range ranked {
+		order[r] = i
+	}
+
+	type pair struct{ first, second rune }
+	seqs := map[pair]int{}
+	for _, text := range corpus {
+		prev := rune(-1)
+		for _, r := range text {
+			r = unicode.ToLower(r)
+			if o, ok := order[r]; ok && o < sampleSize {
+				if prev >= 0 {
+					seqs[pair{prev, r}]++
+				}
+				prev = r
+			} else {
+				prev = -1
+			}
+		}
+	}
+
+	pairs := make([]pair, 0, len(seqs))
+	seqTotal := 0
+	for p, n := range seqs {
+		pairs = append(pairs, p)
+		seqTotal += n
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		a, b := pairs[i], pairs[j]
+		if seqs[a] != seqs[b] {
+			return seqs[a] > seqs[b]
+		}
+		if order[a.first] != order[b.first] {
+			return order[a.first] < order[b.first]
+		}
+		return order[a.second] < order[b.second]
+	})
+	n := min(len(ranked), sampleSize)
+	eighth := n * n / 8
+	likelihood := map[pair]int{}
+	positive := 0
+	for i, p := range pairs {
+		switch {
+		case i < eighth:
+			likelihood[p] = 3
+			positive += seqs[p]
+		case i < 2*eighth:
+			likelihood[p] = 2
+		default:
+			likelihood[p] = 1
+		}
+	}
+
+	// the letters of the model, in alphabetical order
+	var sample []rune
+	for _, r := range ranked {
+		if order[r] < sampleSize {
+			sample = append(sample, r)
+		}
+	}
+	sort.Slice(sample, func(i, j int) bool { return sample[i] < sample[j] })
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_lang_model.go; DO NOT EDIT.\n\n")
+	for _, line := range wrap(*source, 77) {
+		fmt.Fprintf(&b, "// %s\n", line)
+	}
+	fmt.Fprintf(&b, "// The corpus holds %d letters and %d letter pairs.\n\n", total, seqTotal)
+	fmt.Fprintf(&b, "package probe\n\nimport (\n\t\"github.com/wlynxg/chardet/consts\"\n)\n\n")
+
+	fmt.Fprintf(&b, "var %sLangModel = map[int]map[int]int{\n", *name)
+	for _, first := range sample {
+		fmt.Fprintf(&b, "%d: { // %s\n", order[first], quote(first))
+		for _, second := range sample {
+			fmt.Fprintf(&b, "%d: %d, // %s\n", order[second], likelihood[pair{first, second}], quote(second))
+		}
+		fmt.Fprintf(&b, "},\n")
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	for _, cs := range charsets {
+		next := max(len(ranked), sampleSize)
+		var alphabet []rune
+		fmt.Fprintf(&b, "\nfunc %s() *SingleByteCharSetModel {\n", cs.constructor)
+		fmt.Fprintf(&b, "return &SingleByteCharSetModel{\n")
+		fmt.Fprintf(&b, "CharsetName: consts.%s,\nLanguage: consts.%s,\n", cs.constant, *lang)
+		fmt.Fprintf(&b, "CharToOrderMap: [256]int{\n")
+		for i, r := range cs.table {
+			var o int
+			comment := quote(r)
+			switch o2, ok := order[unicode.ToLower(r)]; {
+			case r == utf8.RuneError:
+				o, comment = 255, fmt.Sprintf("'\\x%02x'", i)
+			case r == '\r' || r == '\n':
+				o = 254
+			case r < 0x20 || r == 0x7F:
+				o = 255
+			case ok:
+				o = o2
+				alphabet = append(alphabet, r)
+			case unicode.IsDigit(r):
+				o = 252
+			case r < 0x80 && !unicode.IsLetter(r):
+				o = 253
+			default:
+				o = min(next, 251)
+				next++
+			}
+			fmt.Fprintf(&b, "%d: %d, // %s\n", i, o, comment)
+		}
+		sort.Slice(alphabet, func(i, j int) bool { return alphabet[i] < alphabet[j] })
+		fmt.Fprintf(&b, "},\n")
+		fmt.Fprintf(&b, "LanguageModel: %sLangModel,\n", *name)
+		fmt.Fprintf(&b, "TypicalPositiveRatio: %.6f,\n", float64(positive)/float64(seqTotal))
+		fmt.Fprintf(&b, "KeepAsciiLetters: false,\n")
+		fmt.Fprintf(&b, "Alphabet: %s,\n", strconv.Quote(string(alphabet)))
+		fmt.Fprintf(&b, "}\n}\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// read returns the translations of a gettext message catalog, or the text of
+// any other file.
+func read(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Ext(path) != ".mo" {
+		return []string{string(data)}, nil
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if len(data) < 20 {
+		return nil, fmt.Errorf("%s: not a message catalog", path)
+	}
+	switch binary.LittleEndian.Uint32(data) {
+	case 0x950412DE:
+	case 0xDE120495:
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("%s: not a message catalog", path)
+	}
+	n := int(order.Uint32(data[8:]))
+	originals := int(order.Uint32(data[12:]))
+	translations := int(order.Uint32(data[16:]))
+
+	var texts []string
+	for i := 0; i < n; i++ {
+		if order.Uint32(data[originals+8*i:]) == 0 {
+			// the header of the catalog
+			continue
+		}
+		size := int(order.Uint32(data[translations+8*i:]))
+		offset := int(order.Uint32(data[translations+8*i+4:]))
+		if offset+size > len(data) {
+			return nil, fmt.Errorf("%s: corrupt message catalog", path)
+		}
+		// plural forms are separated by NUL bytes
+		texts = append(texts, strings.Split(string(data[offset:offset+size]), "\x00")...)
+	}
+	return texts, nil
+}
+
+// quote writes r the way the models ported from Python chardet do.
+func quote(r rune) string {
+	switch {
+	case r == '\'':
+		return `"'"`
+	case r == '\\':
+		return `'\\'`
+	case r == '\t':
+		return `'\t'`
+	case r == '\n':
+		return `'\n'`
+	case r == '\r':
+		return `'\r'`
+	case r < 0x100 && !unicode.IsPrint(r):
+		return fmt.Sprintf("'\\x%02x'", r)
+	case !unicode.IsPrint(r):
+		return fmt.Sprintf("'\\u%04x'", r)
+	default:
+		return "'" + string(r) + "'"
+	}
+}
+
+func wrap(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len([]rune(line))+1+len([]rune(word)) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,107 @@
+package probe
+
+import (
+	"github.com/wlynxg/chardet/cda"
+)
+
+// hzValidator checks that the text between the ~{ and ~} shifts of HZ reads
+// as GB2312, so that source code and templates using ~{ are not taken for
+// HZ. Each pair of bytes in GB mode must be an assigned GB2312 code, and the
+// characters must be distributed like Chinese text. A few characters are too
+// few for the distribution, and are only taken for HZ when they are frequent,
+// come back to ASCII with ~} and are not spelled with letters alone, as
+// names such as ~{HOME~} are.
+type hzValidator struct {
+	gb      bool // between ~{ and ~}
+	tilde   bool // after a ~
+	lead    byte // first byte of a pair in GB mode, or zero
+	chars   int
+	invalid int
+	closed  int  // GB mode sections ended with ~}
+	letters bool // the pairs are all written with ASCII letters
+
+	analysis *cda.GB2312DistributionAnalysis
+}
+
+// shortHz is the number of characters up to which the text is too short for
+// the distribution alone.
+const shortHz = 3
+
+func newHzValidator() *hzValidator {
+	v := &hzValidator{analysis: cda.NewGB2312DistributionAnalysis()}
+	v.analysis.MinimumDataThreshold = 0
+	v.reset()
+	return v
+}
+
+func (v *hzValidator) reset() {
+	v.gb = false
+	v.tilde = false
+	v.lead = 0
+	v.chars = 0
+	v.invalid = 0
+	v.closed = 0
+	v.letters = true
+	v.analysis.Reset()
+}
+
+func (v *hzValidator) feed(b byte) {
+	if !v.gb {
+		switch {
+		case v.tilde:
+			v.tilde = false
+			v.gb = b == '{'
+		case b == '~':
+			v.tilde = true
+		}
+		return
+	}
+
+	switch {
+	case v.tilde:
+		v.tilde = false
+		if b == '}' {
+			v.gb = false
+			v.closed++
+		} else {
+			v.invalid++
+		}
+	case b == '~' && v.lead == 0:
+		v.tilde = true
+	case b < 0x21 || b > 0x7E:
+		// GB mode ends at the end of the line, and holds no spaces or
+		// controls
+		v.invalid++
+		v.gb = false
+		v.lead = 0
+	case v.lead == 0:
+		v.lead = b
+	default:
+		v.pair(v.lead, b)
+		v.lead = 0
+	}
+}
+
+func isLetter(b byte) bool {
+	return b >= 'A' && b <= 'Z' || b >= 'a' && b <= 'z'
+}
+
+// pair checks a GB2312 code written with the high bits cleared.
+func (v *hzValidator) pair(lead, trail byte) {
+	// rows 10-15 and 88-94 are unassigned
+	if row := lead - 0x20; (row >= 10 && row <= 15) || row >= 88 {
+		v.invalid++
+		return
+	}
+	v.chars++
+	v.letters = v.letters && isLetter(lead) && isLetter(trail)
+	v.analysis.Feed([]byte{lead | 0x80, trail | 0x80}, 2)
+}
+
+// plausible reports whether the GB mode text seen so far is Chinese.
+func (v *hzValidator) plausible() bool {
+	if v.invalid > 0 || v.analysis.GetConfidence() < 0.5 {
+		return false
+	}
+	return v.chars > shortHz || v.closed > 0 && !v.letters
+}
@@ -0,0 +1,203 @@
+package probe
+
+import (
+	"slices"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+// ISCII-91 character classes.
+const (
+	isciiOther     = iota // ASCII and punctuation
+	isciiSign             // candrabindu, anusvara and visarga
+	isciiVowel            // independent vowel
+	isciiConsonant        // consonant
+	isciiInvisible        // invisible consonant
+	isciiMatra            // dependent vowel sign
+	isciiHalant           // halant (virama)
+	isciiNukta            // nukta
+	isciiDanda            // danda
+	isciiDigit            // digit
+	isciiAttribute        // ATR, followed by a script or display selector
+	isciiExtension        // EXT, followed by a Vedic sign
+	isciiIllegal          // undefined in ISCII-91
+)
+
+func isciiClassOf(b byte) int {
+	switch {
+	case b < 0x80:
+		return isciiOther
+	case b >= 0xA1 && b <= 0xA3:
+		return isciiSign
+	case b >= 0xA4 && b <= 0xB2:
+		return isciiVowel
+	case b >= 0xB3 && b <= 0xD8:
+		return isciiConsonant
+	case b == 0xD9:
+		return isciiInvisible
+	case b >= 0xDA && b <= 0xE7:
+		return isciiMatra
+	case b == 0xE8:
+		return isciiHalant
+	case b == 0xE9:
+		return isciiNukta
+	case b == 0xEA:
+		return isciiDanda
+	case b == 0xEF:
+		return isciiAttribute
+	case b == 0xF0:
+		return isciiExtension
+	case b >= 0xF1 && b <= 0xFA:
+		return isciiDigit
+	default:
+		return isciiIllegal
+	}
+}
+
+// isciiFollows lists, for each class of combining character, the classes it
+// may be written after.
+var isciiFollows = map[int][]int{
+	isciiMatra:  {isciiConsonant, isciiNukta, isciiInvisible},
+	isciiHalant: {isciiConsonant, isciiNukta, isciiInvisible, isciiHalant},
+	isciiNukta:  {isciiConsonant, isciiSign, isciiVowel, isciiMatra, isciiHalant, isciiDanda},
+	isciiSign:   {isciiConsonant, isciiVowel, isciiMatra, isciiNukta},
+}
+
+// isciiLanguages maps the script selectors following ATR to languages.
+var isciiLanguages = map[byte]string{
+	0x42: consts.Hindi,
+	0x43: consts.Bengali,
+	0x44: consts.Tamil,
+	0x45: consts.Telugu,
+	0x46: consts.Assamese,
+	0x47: consts.Oriya,
+	0x48: consts.Kannada,
+	0x49: consts.Malayalam,
+	0x4A: consts.Gujarati,
+	0x4B: consts.Punjabi,
+}
+
+// isciiDefaultScript is the script in effect before the first ATR selector.
+const isciiDefaultScript = 0x42
+
+// IsciiProbe recognises the structure of ISCII-91, the Indian standard code
+// shared by the Brahmi-derived scripts. The bytes 0xA1-0xFA are read the same
+// in every script and an ATR byte selects the script that follows, so the
+// probe checks that vowel signs, halants and nuktas are attached to letters
+// the way the script grammar requires, and reports the language of the script
+// most letters were written in.
+type IsciiProbe struct {
+	CharSetProbe
+
+	// how many letters to see before feeling confident of prediction
+	MinLetters int
+	// the minimum number of combining characters per letter in Indic text
+	MinMarkRatio float64
+
+	lastClass     int
+	pending       int
+	script        byte
+	letters       int
+	marks         int
+	invalid       int
+	scriptLetters map[byte]int
+}
+
+func NewIsciiProbe() *IsciiProbe {
+	p := &IsciiProbe{
+		CharSetProbe: NewCharSetProbe(consts.UnknownLangFilter),
+
+		MinLetters:   16,
+		MinMarkRatio: 0.2,
+	}
+	p.Reset()
+	return p
+}
+
+func (i *IsciiProbe) Reset() {
+	i.CharSetProbe.Reset()
+	i.lastClass = isciiOther
+	i.pending = isciiOther
+	i.script = isciiDefaultScript
+	i.letters = 0
+	i.marks = 0
+	i.invalid = 0
+	i.scriptLetters = make(map[byte]int)
+}
+
+func (i *IsciiProbe) CharSetName() string {
+	return consts.ISCII
+}
+
+func (i *IsciiProbe) Language() string {
+	script, most := byte(isciiDefaultScript), 0
+	for s, n := range i.scriptLetters {
+		if n > most || (n == most && s < script) {
+			script, most = s, n
+		}
+	}
+	return isciiLanguages[script]
+}
+
+func (i *IsciiProbe) Feed(buf []byte) consts.ProbingState {
+	for at, b := range buf {
+		switch i.pending {
+		case isciiAttribute:
+			// 0x30-0x3F select display attributes, 0x40-0x4B select scripts
+			if _, ok := isciiLanguages[b]; ok {
+				i.script = b
+			} else if b < 0x30 || b > 0x4B {
+				i.invalid++
+			}
+			i.pending = isciiOther
+			i.lastClass = isciiOther
+			continue
+		case isciiExtension:
+			i.pending = isciiOther
+			i.lastClass = isciiOther
+			continue
+		}
+
+		class := isciiClassOf(b)
+		switch class {
+		case isciiIllegal:
+			i.decide(consts.NotMeProbingState, at, "illegal byte 0x%02X", b)
+			return i.state
+		case isciiAttribute, isciiExtension:
+			i.pending = class
+		case isciiVowel, isciiConsonant:
+			i.letters++
+			i.scriptLetters[i.script]++
+		}
+
+		if follows, ok := isciiFollows[class]; ok {
+			if slices.Contains(follows, i.lastClass) {
+				i.marks++
+			} else {
+				i.invalid++
+			}
+		}
+		i.lastClass = class
+	}
+
+	if i.state == consts.DetectingProbingState && i.invalid > 16 && i.invalid > i.marks {
+		i.decide(consts.NotMeProbingState, len(buf), "%d misplaced signs outnumber %d attached ones", i.invalid, i.marks)
+	}
+	return i.state
+}
+
+func (i *IsciiProbe) GetConfidence() float64 {
+	return indicConfidence(i.letters, i.marks, i.invalid, i.MinLetters, i.MinMarkRatio)
+}
+
+// indicConfidence scores Indic text from the number of letters, of combining
+// characters correctly attached to a letter and of misplaced ones.
+func indicConfidence(letters, marks, invalid, minLetters int, minMarkRatio float64) float64 {
+	if letters < minLetters || float64(marks) < float64(letters)*minMarkRatio {
+		return 0.01
+	}
+
+	// a misplaced sign weighs much more than a well-formed one
+	confidence := float64(marks-invalid*5) / float64(marks+invalid)
+	return max(confidence, 0.01) * 0.95
+}
@@ -0,0 +1,2589 @@
+// Code generated by gen_lang_model.go; DO NOT EDIT.
+
+// Trained on the Arabic translations shipped by Debian 12.12: the message
+// catalogs of Linux-PAM, PackageKit, AppStream, APT, GLib, python-apt,
+// shared-mime-info, software-properties, xdg-user-dirs and xkeyboard-config,
+// and the names of iso-codes 4.15.0 (iso_15924, iso_3166-1, iso_3166-3,
+// iso_4217, iso_639-2 and iso_639-3).
+// The corpus holds 51214 letters and 41146 letter pairs.
+
+package probe
+
+import (
+	"github.com/wlynxg/chardet/consts"
+)
+
+var arabicLangModel = map[int]map[int]int{
+	31: { // 'ء'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  1, // 'ا'
+		10: 0, // 'ب'
+		6:  2, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	33: { // 'آ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 2, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 2, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  1, // 'ر'
+		24: 0, // 'ز'
+		12: 1, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  2, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  2, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	19: { // 'أ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 2, // 'ب'
+		6:  0, // 'ة'
+		7:  1, // 'ت'
+		28: 2, // 'ث'
+		15: 2, // 'ج'
+		16: 2, // 'ح'
+		25: 2, // 'خ'
+		9:  2, // 'د'
+		30: 2, // 'ذ'
+		4:  3, // 'ر'
+		24: 2, // 'ز'
+		12: 2, // 'س'
+		21: 0, // 'ش'
+		18: 2, // 'ص'
+		29: 1, // 'ض'
+		22: 1, // 'ط'
+		34: 2, // 'ظ'
+		14: 2, // 'ع'
+		20: 2, // 'غ'
+		11: 2, // 'ف'
+		17: 2, // 'ق'
+		13: 2, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 0, // 'ه'
+		5:  3, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 1, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	35: { // 'ؤ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 1, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 2, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 2, // 'ق'
+		13: 0, // 'ك'
+		1:  1, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  1, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	26: { // 'إ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 2, // 'ب'
+		6:  0, // 'ة'
+		7:  1, // 'ت'
+		28: 2, // 'ث'
+		15: 2, // 'ج'
+		16: 2, // 'ح'
+		25: 2, // 'خ'
+		9:  2, // 'د'
+		30: 2, // 'ذ'
+		4:  2, // 'ر'
+		24: 2, // 'ز'
+		12: 3, // 'س'
+		21: 2, // 'ش'
+		18: 2, // 'ص'
+		29: 2, // 'ض'
+		22: 1, // 'ط'
+		34: 0, // 'ظ'
+		14: 2, // 'ع'
+		20: 2, // 'غ'
+		11: 2, // 'ف'
+		17: 0, // 'ق'
+		13: 1, // 'ك'
+		1:  3, // 'ل'
+		3:  2, // 'م'
+		8:  3, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	32: { // 'ئ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  1, // 'ا'
+		10: 0, // 'ب'
+		6:  2, // 'ة'
+		7:  1, // 'ت'
+		28: 0, // 'ث'
+		15: 1, // 'ج'
+		16: 1, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  2, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 1, // 'ص'
+		29: 0, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 1, // 'ق'
+		13: 0, // 'ك'
+		1:  2, // 'ل'
+		3:  2, // 'م'
+		8:  2, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 1, // 'ى'
+		2:  2, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	0: { // 'ا'
+		31: 3, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 3, // 'ئ'
+		0:  1, // 'ا'
+		10: 3, // 'ب'
+		6:  2, // 'ة'
+		7:  3, // 'ت'
+		28: 2, // 'ث'
+		15: 3, // 'ج'
+		16: 3, // 'ح'
+		25: 3, // 'خ'
+		9:  3, // 'د'
+		30: 2, // 'ذ'
+		4:  3, // 'ر'
+		24: 3, // 'ز'
+		12: 3, // 'س'
+		21: 3, // 'ش'
+		18: 3, // 'ص'
+		29: 2, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 3, // 'ع'
+		20: 2, // 'غ'
+		11: 3, // 'ف'
+		17: 3, // 'ق'
+		13: 3, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 2, // 'ه'
+		5:  3, // 'و'
+		27: 2, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	10: { // 'ب'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 2, // 'أ'
+		35: 0, // 'ؤ'
+		26: 1, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 1, // 'ب'
+		6:  3, // 'ة'
+		7:  3, // 'ت'
+		28: 2, // 'ث'
+		15: 2, // 'ج'
+		16: 2, // 'ح'
+		25: 1, // 'خ'
+		9:  3, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 1, // 'ز'
+		12: 2, // 'س'
+		21: 2, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 3, // 'ع'
+		20: 0, // 'غ'
+		11: 1, // 'ف'
+		17: 2, // 'ق'
+		13: 2, // 'ك'
+		1:  3, // 'ل'
+		3:  2, // 'م'
+		8:  3, // 'ن'
+		23: 2, // 'ه'
+		5:  3, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	6: { // 'ة'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	7: { // 'ت'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 2, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 3, // 'ب'
+		6:  3, // 'ة'
+		7:  2, // 'ت'
+		28: 2, // 'ث'
+		15: 2, // 'ج'
+		16: 3, // 'ح'
+		25: 3, // 'خ'
+		9:  2, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 1, // 'ز'
+		12: 2, // 'س'
+		21: 3, // 'ش'
+		18: 3, // 'ص'
+		29: 2, // 'ض'
+		22: 2, // 'ط'
+		34: 2, // 'ظ'
+		14: 3, // 'ع'
+		20: 3, // 'غ'
+		11: 2, // 'ف'
+		17: 2, // 'ق'
+		13: 2, // 'ك'
+		1:  2, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 3, // 'ه'
+		5:  3, // 'و'
+		27: 2, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	28: { // 'ث'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 2, // 'ب'
+		6:  2, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  2, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 1, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 1, // 'ق'
+		13: 0, // 'ك'
+		1:  2, // 'ل'
+		3:  2, // 'م'
+		8:  2, // 'ن'
+		23: 0, // 'ه'
+		5:  2, // 'و'
+		27: 0, // 'ى'
+		2:  2, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	15: { // 'ج'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 2, // 'ب'
+		6:  2, // 'ة'
+		7:  1, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 1, // 'ح'
+		25: 0, // 'خ'
+		9:  3, // 'د'
+		30: 1, // 'ذ'
+		4:  3, // 'ر'
+		24: 3, // 'ز'
+		12: 2, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 2, // 'ع'
+		20: 2, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 1, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  2, // 'ن'
+		23: 2, // 'ه'
+		5:  3, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	16: { // 'ح'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 1, // 'ب'
+		6:  3, // 'ة'
+		7:  3, // 'ت'
+		28: 2, // 'ث'
+		15: 2, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  3, // 'د'
+		30: 2, // 'ذ'
+		4:  3, // 'ر'
+		24: 3, // 'ز'
+		12: 2, // 'س'
+		21: 0, // 'ش'
+		18: 2, // 'ص'
+		29: 2, // 'ض'
+		22: 1, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 2, // 'ق'
+		13: 0, // 'ك'
+		1:  2, // 'ل'
+		3:  2, // 'م'
+		8:  1, // 'ن'
+		23: 1, // 'ه'
+		5:  2, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	25: { // 'خ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 2, // 'ب'
+		6:  2, // 'ة'
+		7:  2, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  2, // 'د'
+		30: 1, // 'ذ'
+		4:  2, // 'ر'
+		24: 2, // 'ز'
+		12: 2, // 'س'
+		21: 0, // 'ش'
+		18: 2, // 'ص'
+		29: 1, // 'ض'
+		22: 3, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  3, // 'ل'
+		3:  2, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  2, // 'و'
+		27: 1, // 'ى'
+		2:  2, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	9: { // 'د'
+		31: 2, // 'ء'
+		33: 0, // 'آ'
+		19: 2, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 2, // 'ئ'
+		0:  3, // 'ا'
+		10: 1, // 'ب'
+		6:  3, // 'ة'
+		7:  0, // 'ت'
+		28: 2, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 2, // 'خ'
+		9:  3, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 2, // 'ز'
+		12: 1, // 'س'
+		21: 1, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 3, // 'ع'
+		20: 1, // 'غ'
+		11: 2, // 'ف'
+		17: 2, // 'ق'
+		13: 1, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  2, // 'ن'
+		23: 1, // 'ه'
+		5:  3, // 'و'
+		27: 2, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	30: { // 'ذ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  2, // 'ا'
+		10: 0, // 'ب'
+		6:  1, // 'ة'
+		7:  1, // 'ت'
+		28: 0, // 'ث'
+		15: 1, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 2, // 'ف'
+		17: 0, // 'ق'
+		13: 1, // 'ك'
+		1:  1, // 'ل'
+		3:  0, // 'م'
+		8:  1, // 'ن'
+		23: 2, // 'ه'
+		5:  1, // 'و'
+		27: 0, // 'ى'
+		2:  2, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	4: { // 'ر'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 1, // 'أ'
+		35: 1, // 'ؤ'
+		26: 0, // 'إ'
+		32: 1, // 'ئ'
+		0:  3, // 'ا'
+		10: 3, // 'ب'
+		6:  3, // 'ة'
+		7:  3, // 'ت'
+		28: 1, // 'ث'
+		15: 3, // 'ج'
+		16: 2, // 'ح'
+		25: 1, // 'خ'
+		9:  3, // 'د'
+		30: 0, // 'ذ'
+		4:  1, // 'ر'
+		24: 2, // 'ز'
+		12: 3, // 'س'
+		21: 3, // 'ش'
+		18: 3, // 'ص'
+		29: 3, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 2, // 'ع'
+		20: 2, // 'غ'
+		11: 3, // 'ف'
+		17: 3, // 'ق'
+		13: 3, // 'ك'
+		1:  2, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 2, // 'ه'
+		5:  3, // 'و'
+		27: 2, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	24: { // 'ز'
+		31: 2, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 2, // 'ئ'
+		0:  3, // 'ا'
+		10: 2, // 'ب'
+		6:  2, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 1, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  2, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 0, // 'ز'
+		12: 2, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  2, // 'ل'
+		3:  3, // 'م'
+		8:  2, // 'ن'
+		23: 0, // 'ه'
+		5:  2, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	12: { // 'س'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 3, // 'ب'
+		6:  2, // 'ة'
+		7:  3, // 'ت'
+		28: 0, // 'ث'
+		15: 2, // 'ج'
+		16: 1, // 'ح'
+		25: 2, // 'خ'
+		9:  1, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 2, // 'ع'
+		20: 2, // 'غ'
+		11: 2, // 'ف'
+		17: 1, // 'ق'
+		13: 3, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 2, // 'ه'
+		5:  3, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	21: { // 'ش'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 1, // 'ئ'
+		0:  3, // 'ا'
+		10: 1, // 'ب'
+		6:  2, // 'ة'
+		7:  2, // 'ت'
+		28: 0, // 'ث'
+		15: 1, // 'ج'
+		16: 1, // 'ح'
+		25: 2, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  2, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 1, // 'ط'
+		34: 0, // 'ظ'
+		14: 2, // 'ع'
+		20: 2, // 'غ'
+		11: 3, // 'ف'
+		17: 2, // 'ق'
+		13: 2, // 'ك'
+		1:  3, // 'ل'
+		3:  2, // 'م'
+		8:  0, // 'ن'
+		23: 2, // 'ه'
+		5:  2, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	18: { // 'ص'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 0, // 'ب'
+		6:  2, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 2, // 'ح'
+		25: 0, // 'خ'
+		9:  3, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 2, // 'ص'
+		29: 0, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 3, // 'ف'
+		17: 1, // 'ق'
+		13: 0, // 'ك'
+		1:  3, // 'ل'
+		3:  2, // 'م'
+		8:  2, // 'ن'
+		23: 0, // 'ه'
+		5:  3, // 'و'
+		27: 2, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	29: { // 'ض'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  2, // 'ا'
+		10: 2, // 'ب'
+		6:  1, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  2, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 1, // 'ع'
+		20: 3, // 'غ'
+		11: 1, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  1, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  2, // 'و'
+		27: 0, // 'ى'
+		2:  2, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	22: { // 'ط'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 3, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 1, // 'ئ'
+		0:  3, // 'ا'
+		10: 3, // 'ب'
+		6:  3, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 2, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  2, // 'ر'
+		24: 0, // 'ز'
+		12: 2, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 2, // 'ع'
+		20: 0, // 'غ'
+		11: 1, // 'ف'
+		17: 2, // 'ق'
+		13: 0, // 'ك'
+		1:  2, // 'ل'
+		3:  0, // 'م'
+		8:  2, // 'ن'
+		23: 2, // 'ه'
+		5:  2, // 'و'
+		27: 2, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	34: { // 'ظ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  2, // 'ا'
+		10: 0, // 'ب'
+		6:  1, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  1, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  1, // 'م'
+		8:  0, // 'ن'
+		23: 2, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  1, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	14: { // 'ع'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 2, // 'ب'
+		6:  3, // 'ة'
+		7:  2, // 'ت'
+		28: 2, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  3, // 'د'
+		30: 3, // 'ذ'
+		4:  3, // 'ر'
+		24: 0, // 'ز'
+		12: 2, // 'س'
+		21: 1, // 'ش'
+		18: 0, // 'ص'
+		29: 2, // 'ض'
+		22: 2, // 'ط'
+		34: 1, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 1, // 'ف'
+		17: 0, // 'ق'
+		13: 1, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 0, // 'ه'
+		5:  2, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	20: { // 'غ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 2, // 'ب'
+		6:  2, // 'ة'
+		7:  1, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 1, // 'ذ'
+		4:  3, // 'ر'
+		24: 1, // 'ز'
+		12: 2, // 'س'
+		21: 1, // 'ش'
+		18: 0, // 'ص'
+		29: 1, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  2, // 'ل'
+		3:  1, // 'م'
+		8:  2, // 'ن'
+		23: 0, // 'ه'
+		5:  3, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	11: { // 'ف'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 1, // 'إ'
+		32: 1, // 'ئ'
+		0:  3, // 'ا'
+		10: 2, // 'ب'
+		6:  3, // 'ة'
+		7:  3, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 2, // 'ح'
+		25: 0, // 'خ'
+		9:  1, // 'د'
+		30: 2, // 'ذ'
+		4:  3, // 'ر'
+		24: 0, // 'ز'
+		12: 2, // 'س'
+		21: 3, // 'ش'
+		18: 2, // 'ص'
+		29: 1, // 'ض'
+		22: 0, // 'ط'
+		34: 1, // 'ظ'
+		14: 2, // 'ع'
+		20: 2, // 'غ'
+		11: 0, // 'ف'
+		17: 3, // 'ق'
+		13: 2, // 'ك'
+		1:  3, // 'ل'
+		3:  2, // 'م'
+		8:  2, // 'ن'
+		23: 1, // 'ه'
+		5:  3, // 'و'
+		27: 1, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	17: { // 'ق'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 2, // 'ب'
+		6:  2, // 'ة'
+		7:  2, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 1, // 'ح'
+		25: 0, // 'خ'
+		9:  3, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 0, // 'ز'
+		12: 1, // 'س'
+		21: 1, // 'ش'
+		18: 2, // 'ص'
+		29: 0, // 'ض'
+		22: 3, // 'ط'
+		34: 0, // 'ظ'
+		14: 3, // 'ع'
+		20: 0, // 'غ'
+		11: 3, // 'ف'
+		17: 1, // 'ق'
+		13: 0, // 'ك'
+		1:  2, // 'ل'
+		3:  2, // 'م'
+		8:  2, // 'ن'
+		23: 1, // 'ه'
+		5:  3, // 'و'
+		27: 2, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	13: { // 'ك'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 3, // 'ب'
+		6:  3, // 'ة'
+		7:  3, // 'ت'
+		28: 1, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 1, // 'ز'
+		12: 3, // 'س'
+		21: 2, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 2, // 'ع'
+		20: 0, // 'غ'
+		11: 1, // 'ف'
+		17: 2, // 'ق'
+		13: 0, // 'ك'
+		1:  3, // 'ل'
+		3:  2, // 'م'
+		8:  3, // 'ن'
+		23: 0, // 'ه'
+		5:  3, // 'و'
+		27: 0, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	1: { // 'ل'
+		31: 0, // 'ء'
+		33: 2, // 'آ'
+		19: 3, // 'أ'
+		35: 0, // 'ؤ'
+		26: 3, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 3, // 'ب'
+		6:  3, // 'ة'
+		7:  3, // 'ت'
+		28: 3, // 'ث'
+		15: 3, // 'ج'
+		16: 3, // 'ح'
+		25: 3, // 'خ'
+		9:  3, // 'د'
+		30: 2, // 'ذ'
+		4:  3, // 'ر'
+		24: 2, // 'ز'
+		12: 3, // 'س'
+		21: 3, // 'ش'
+		18: 3, // 'ص'
+		29: 2, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 3, // 'ع'
+		20: 3, // 'غ'
+		11: 3, // 'ف'
+		17: 3, // 'ق'
+		13: 3, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 3, // 'ه'
+		5:  3, // 'و'
+		27: 3, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 1, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	3: { // 'م'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 2, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 3, // 'ب'
+		6:  3, // 'ة'
+		7:  3, // 'ت'
+		28: 2, // 'ث'
+		15: 3, // 'ج'
+		16: 3, // 'ح'
+		25: 2, // 'خ'
+		9:  3, // 'د'
+		30: 0, // 'ذ'
+		4:  3, // 'ر'
+		24: 3, // 'ز'
+		12: 3, // 'س'
+		21: 2, // 'ش'
+		18: 3, // 'ص'
+		29: 3, // 'ض'
+		22: 2, // 'ط'
+		34: 0, // 'ظ'
+		14: 3, // 'ع'
+		20: 2, // 'غ'
+		11: 3, // 'ف'
+		17: 2, // 'ق'
+		13: 3, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 3, // 'ه'
+		5:  3, // 'و'
+		27: 1, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	8: { // 'ن'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 2, // 'ب'
+		6:  2, // 'ة'
+		7:  3, // 'ت'
+		28: 0, // 'ث'
+		15: 3, // 'ج'
+		16: 1, // 'ح'
+		25: 2, // 'خ'
+		9:  3, // 'د'
+		30: 0, // 'ذ'
+		4:  2, // 'ر'
+		24: 2, // 'ز'
+		12: 3, // 'س'
+		21: 2, // 'ش'
+		18: 3, // 'ص'
+		29: 0, // 'ض'
+		22: 2, // 'ط'
+		34: 2, // 'ظ'
+		14: 1, // 'ع'
+		20: 3, // 'غ'
+		11: 2, // 'ف'
+		17: 3, // 'ق'
+		13: 3, // 'ك'
+		1:  2, // 'ل'
+		3:  3, // 'م'
+		8:  0, // 'ن'
+		23: 2, // 'ه'
+		5:  3, // 'و'
+		27: 2, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	23: { // 'ه'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  3, // 'ا'
+		10: 1, // 'ب'
+		6:  2, // 'ة'
+		7:  2, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  2, // 'د'
+		30: 2, // 'ذ'
+		4:  2, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  2, // 'ل'
+		3:  2, // 'م'
+		8:  2, // 'ن'
+		23: 0, // 'ه'
+		5:  3, // 'و'
+		27: 2, // 'ى'
+		2:  2, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	5: { // 'و'
+		31: 1, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 1, // 'إ'
+		32: 1, // 'ئ'
+		0:  3, // 'ا'
+		10: 3, // 'ب'
+		6:  0, // 'ة'
+		7:  3, // 'ت'
+		28: 2, // 'ث'
+		15: 3, // 'ج'
+		16: 3, // 'ح'
+		25: 1, // 'خ'
+		9:  3, // 'د'
+		30: 1, // 'ذ'
+		4:  3, // 'ر'
+		24: 3, // 'ز'
+		12: 3, // 'س'
+		21: 3, // 'ش'
+		18: 3, // 'ص'
+		29: 2, // 'ض'
+		22: 3, // 'ط'
+		34: 1, // 'ظ'
+		14: 3, // 'ع'
+		20: 2, // 'غ'
+		11: 3, // 'ف'
+		17: 3, // 'ق'
+		13: 3, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 2, // 'ه'
+		5:  2, // 'و'
+		27: 3, // 'ى'
+		2:  3, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	27: { // 'ى'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	2: { // 'ي'
+		31: 2, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 2, // 'ؤ'
+		26: 0, // 'إ'
+		32: 2, // 'ئ'
+		0:  3, // 'ا'
+		10: 3, // 'ب'
+		6:  3, // 'ة'
+		7:  3, // 'ت'
+		28: 2, // 'ث'
+		15: 3, // 'ج'
+		16: 3, // 'ح'
+		25: 2, // 'خ'
+		9:  3, // 'د'
+		30: 2, // 'ذ'
+		4:  3, // 'ر'
+		24: 3, // 'ز'
+		12: 3, // 'س'
+		21: 2, // 'ش'
+		18: 2, // 'ص'
+		29: 2, // 'ض'
+		22: 3, // 'ط'
+		34: 0, // 'ظ'
+		14: 3, // 'ع'
+		20: 2, // 'غ'
+		11: 3, // 'ف'
+		17: 3, // 'ق'
+		13: 3, // 'ك'
+		1:  3, // 'ل'
+		3:  3, // 'م'
+		8:  3, // 'ن'
+		23: 2, // 'ه'
+		5:  3, // 'و'
+		27: 1, // 'ى'
+		2:  2, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	37: { // 'ٹ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	38: { // 'پ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	39: { // 'چ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	40: { // 'ڈ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	41: { // 'ڑ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	42: { // 'ژ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	36: { // 'ڤ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 1, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  1, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	43: { // 'گ'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	44: { // 'ں'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	45: { // 'ے'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+	46: { // 'ە'
+		31: 0, // 'ء'
+		33: 0, // 'آ'
+		19: 0, // 'أ'
+		35: 0, // 'ؤ'
+		26: 0, // 'إ'
+		32: 0, // 'ئ'
+		0:  0, // 'ا'
+		10: 0, // 'ب'
+		6:  0, // 'ة'
+		7:  0, // 'ت'
+		28: 0, // 'ث'
+		15: 0, // 'ج'
+		16: 0, // 'ح'
+		25: 0, // 'خ'
+		9:  0, // 'د'
+		30: 0, // 'ذ'
+		4:  0, // 'ر'
+		24: 0, // 'ز'
+		12: 0, // 'س'
+		21: 0, // 'ش'
+		18: 0, // 'ص'
+		29: 0, // 'ض'
+		22: 0, // 'ط'
+		34: 0, // 'ظ'
+		14: 0, // 'ع'
+		20: 0, // 'غ'
+		11: 0, // 'ف'
+		17: 0, // 'ق'
+		13: 0, // 'ك'
+		1:  0, // 'ل'
+		3:  0, // 'م'
+		8:  0, // 'ن'
+		23: 0, // 'ه'
+		5:  0, // 'و'
+		27: 0, // 'ى'
+		2:  0, // 'ي'
+		37: 0, // 'ٹ'
+		38: 0, // 'پ'
+		39: 0, // 'چ'
+		40: 0, // 'ڈ'
+		41: 0, // 'ڑ'
+		42: 0, // 'ژ'
+		36: 0, // 'ڤ'
+		43: 0, // 'گ'
+		44: 0, // 'ں'
+		45: 0, // 'ے'
+		46: 0, // 'ە'
+	},
+}
+
+func NewMacArabicArabicModel() *SingleByteCharSetModel {
+	return &SingleByteCharSetModel{
+		CharsetName: consts.MacArabic,
+		Language:    consts.Arabic,
+		CharToOrderMap: [256]int{
+			0:   255, // '\x00'
+			1:   255, // '\x01'
+			2:   255, // '\x02'
+			3:   255, // '\x03'
+			4:   255, // '\x04'
+			5:   255, // '\x05'
+			6:   255, // '\x06'
+			7:   255, // '\x07'
+			8:   255, // '\x08'
+			9:   255, // '\t'
+			10:  254, // '\n'
+			11:  255, // '\x0b'
+			12:  255, // '\x0c'
+			13:  254, // '\r'
+			14:  255, // '\x0e'
+			15:  255, // '\x0f'
+			16:  255, // '\x10'
+			17:  255, // '\x11'
+			18:  255, // '\x12'
+			19:  255, // '\x13'
+			20:  255, // '\x14'
+			21:  255, // '\x15'
+			22:  255, // '\x16'
+			23:  255, // '\x17'
+			24:  255, // '\x18'
+			25:  255, // '\x19'
+			26:  255, // '\x1a'
+			27:  255, // '\x1b'
+			28:  255, // '\x1c'
+			29:  255, // '\x1d'
+			30:  255, // '\x1e'
+			31:  255, // '\x1f'
+			32:  253, // ' '
+			33:  253, // '!'
+			34:  253, // '"'
+			35:  253, // '#'
+			36:  253, // '$'
+			37:  253, // '%'
+			38:  253, // '&'
+			39:  253, // "'"
+			40:  253, // '('
+			41:  253, // ')'
+			42:  253, // '*'
+			43:  253, // '+'
+			44:  253, // ','
+			45:  253, // '-'
+			46:  253, // '.'
+			47:  253, // '/'
+			48:  252, // '0'
+			49:  252, // '1'
+			50:  252, // '2'
+			51:  252, // '3'
+			52:  252, // '4'
+			53:  252, // '5'
+			54:  252, // '6'
+			55:  252, // '7'
+			56:  252, // '8'
+			57:  252, // '9'
+			58:  253, // ':'
+			59:  253, // ';'
+			60:  253, // '<'
+			61:  253, // '='
+			62:  253, // '>'
+			63:  253, // '?'
+			64:  253, // '@'
+			65:  64,  // 'A'
+			66:  65,  // 'B'
+			67:  66,  // 'C'
+			68:  67,  // 'D'
+			69:  68,  // 'E'
+			70:  69,  // 'F'
+			71:  70,  // 'G'
+			72:  71,  // 'H'
+			73:  72,  // 'I'
+			74:  73,  // 'J'
+			75:  74,  // 'K'
+			76:  75,  // 'L'
+			77:  76,  // 'M'
+			78:  77,  // 'N'
+			79:  78,  // 'O'
+			80:  79,  // 'P'
+			81:  80,  // 'Q'
+			82:  81,  // 'R'
+			83:  82,  // 'S'
+			84:  83,  // 'T'
+			85:  84,  // 'U'
+			86:  85,  // 'V'
+			87:  86,  // 'W'
+			88:  87,  // 'X'
+			89:  88,  // 'Y'
+			90:  89,  // 'Z'
+			91:  253, // '['
+			92:  253, // '\\'
+			93:  253, // ']'
+			94:  253, // '^'
+			95:  253, // '_'
+			96:  253, // '`'
+			97:  90,  // 'a'
+			98:  91,  // 'b'
+			99:  92,  // 'c'
+			100: 93,  // 'd'
+			101: 94,  // 'e'
+			102: 95,  // 'f'
+			103: 96,  // 'g'
+			104: 97,  // 'h'
+			105: 98,  // 'i'
+			106: 99,  // 'j'
+			107: 100, // 'k'
+			108: 101, // 'l'
+			109: 102, // 'm'
+			110: 103, // 'n'
+			111: 104, // 'o'
+			112: 105, // 'p'
+			113: 106, // 'q'
+			114: 107, // 'r'
+			115: 108, // 's'
+			116: 109, // 't'
+			117: 110, // 'u'
+			118: 111, // 'v'
+			119: 112, // 'w'
+			120: 113, // 'x'
+			121: 114, // 'y'
+			122: 115, // 'z'
+			123: 253, // '{'
+			124: 253, // '|'
+			125: 253, // '}'
+			126: 253, // '~'
+			127: 255, // '\x7f'
+			128: 116, // 'Ä'
+			129: 117, // '\xa0'
+			130: 118, // 'Ç'
+			131: 119, // 'É'
+			132: 120, // 'Ñ'
+			133: 121, // 'Ö'
+			134: 122, // 'Ü'
+			135: 123, // 'á'
+			136: 124, // 'à'
+			137: 125, // 'â'
+			138: 126, // 'ä'
+			139: 44,  // 'ں'
+			140: 127, // '«'
+			141: 128, // 'ç'
+			142: 129, // 'é'
+			143: 130, // 'è'
+			144: 131, // 'ê'
+			145: 132, // 'ë'
+			146: 133, // 'í'
+			147: 134, // '…'
+			148: 135, // 'î'
+			149: 136, // 'ï'
+			150: 137, // 'ñ'
+			151: 138, // 'ó'
+			152: 139, // '»'
+			153: 140, // 'ô'
+			154: 141, // 'ö'
+			155: 142, // '÷'
+			156: 143, // 'ú'
+			157: 144, // 'ù'
+			158: 145, // 'û'
+			159: 146, // 'ü'
+			160: 253, // ' '
+			161: 253, // '!'
+			162: 253, // '"'
+			163: 253, // '#'
+			164: 253, // '$'
+			165: 147, // '٪'
+			166: 253, // '&'
+			167: 253, // "'"
+			168: 253, // '('
+			169: 253, // ')'
+			170: 253, // '*'
+			171: 253, // '+'
+			172: 148, // '،'
+			173: 253, // '-'
+			174: 253, // '.'
+			175: 253, // '/'
+			176: 252, // '٠'
+			177: 252, // '١'
+			178: 252, // '٢'
+			179: 252, // '٣'
+			180: 252, // '٤'
+			181: 252, // '٥'
+			182: 252, // '٦'
+			183: 252, // '٧'
+			184: 252, // '٨'
+			185: 252, // '٩'
+			186: 253, // ':'
+			187: 149, // '؛'
+			188: 253, // '<'
+			189: 253, // '='
+			190: 253, // '>'
+			191: 150, // '؟'
+			192: 151, // '❊'
+			193: 31,  // 'ء'
+			194: 33,  // 'آ'
+			195: 19,  // 'أ'
+			196: 35,  // 'ؤ'
+			197: 26,  // 'إ'
+			198: 32,  // 'ئ'
+			199: 0,   // 'ا'
+			200: 10,  // 'ب'
+			201: 6,   // 'ة'
+			202: 7,   // 'ت'
+			203: 28,  // 'ث'
+			204: 15,  // 'ج'
+			205: 16,  // 'ح'
+			206: 25,  // 'خ'
+			207: 9,   // 'د'
+			208: 30,  // 'ذ'
+			209: 4,   // 'ر'
+			210: 24,  // 'ز'
+			211: 12,  // 'س'
+			212: 21,  // 'ش'
+			213: 18,  // 'ص'
+			214: 29,  // 'ض'
+			215: 22,  // 'ط'
+			216: 34,  // 'ظ'
+			217: 14,  // 'ع'
+			218: 20,  // 'غ'
+			219: 253, // '['
+			220: 253, // '\\'
+			221: 253, // ']'
+			222: 253, // '^'
+			223: 253, // '_'
+			224: 152, // 'ـ'
+			225: 11,  // 'ف'
+			226: 17,  // 'ق'
+			227: 13,  // 'ك'
+			228: 1,   // 'ل'
+			229: 3,   // 'م'
+			230: 8,   // 'ن'
+			231: 23,  // 'ه'
+			232: 5,   // 'و'
+			233: 27,  // 'ى'
+			234: 2,   // 'ي'
+			235: 153, // 'ً'
+			236: 154, // 'ٌ'
+			237: 155, // 'ٍ'
+			238: 156, // 'َ'
+			239: 157, // 'ُ'
+			240: 158, // 'ِ'
+			241: 159, // 'ّ'
+			242: 160, // 'ْ'
+			243: 38,  // 'پ'
+			244: 37,  // 'ٹ'
+			245: 39,  // 'چ'
+			246: 46,  // 'ە'
+			247: 36,  // 'ڤ'
+			248: 43,  // 'گ'
+			249: 40,  // 'ڈ'
+			250: 41,  // 'ڑ'
+			251: 253, // '{'
+			252: 253, // '|'
+			253: 253, // '}'
+			254: 42,  // 'ژ'
+			255: 45,  // 'ے'
+		},
+		LanguageModel:        arabicLangModel,
+		TypicalPositiveRatio: 0.916128,
+		KeepAsciiLetters:     false,
+		Alphabet:             "ءآأؤإئابةتثجحخدذرزسشصضطظعغفقكلمنهوىيٹپچڈڑژڤگںےە",
+	}
+}
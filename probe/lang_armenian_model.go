@@ -0,0 +1,1883 @@
+// Code generated by gen_lang_model.go; DO NOT EDIT.
+
+// Trained on the Armenian translations shipped by Debian 12: the message
+// catalogs of GLib (glib20.mo from libglib2.0-data 2.74.6) and the country
+// names of iso-codes 4.15.0 (iso_3166-1.mo, iso_3166-3.mo).
+// The corpus holds 28758 letters and 24517 letter pairs.
+
+package probe
+
+import (
+	"github.com/wlynxg/chardet/consts"
+)
+
+var armenianLangModel = map[int]map[int]int{
+	0: { // 'ա'
+		0:  0, // 'ա'
+		20: 3, // 'բ'
+		23: 3, // 'գ'
+		22: 3, // 'դ'
+		5:  1, // 'ե'
+		32: 3, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 2, // 'թ'
+		34: 2, // 'ժ'
+		3:  2, // 'ի'
+		8:  3, // 'լ'
+		25: 2, // 'խ'
+		26: 3, // 'ծ'
+		12: 3, // 'կ'
+		14: 3, // 'հ'
+		37: 1, // 'ձ'
+		18: 3, // 'ղ'
+		38: 1, // 'ճ'
+		9:  3, // 'մ'
+		11: 3, // 'յ'
+		2:  3, // 'ն'
+		29: 3, // 'շ'
+		1:  2, // 'ո'
+		21: 2, // 'չ'
+		15: 3, // 'պ'
+		27: 3, // 'ջ'
+		33: 3, // 'ռ'
+		13: 3, // 'ս'
+		10: 3, // 'վ'
+		7:  3, // 'տ'
+		4:  3, // 'ր'
+		19: 3, // 'ց'
+		6:  0, // 'ւ'
+		31: 3, // 'փ'
+		28: 2, // 'ք'
+		36: 0, // 'օ'
+		30: 2, // 'ֆ'
+		35: 0, // 'և'
+	},
+	20: { // 'բ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 2, // 'ը'
+		16: 2, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  2, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 2, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  2, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	23: { // 'գ'
+		0:  3, // 'ա'
+		20: 1, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 1, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  2, // 'լ'
+		25: 0, // 'խ'
+		26: 2, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 0, // 'յ'
+		2:  2, // 'ն'
+		29: 1, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 2, // 'վ'
+		7:  3, // 'տ'
+		4:  3, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	22: { // 'դ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 2, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  2, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 1, // 'կ'
+		14: 1, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 2, // 'յ'
+		2:  1, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 1, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  3, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 1, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	5: { // 'ե'
+		0:  2, // 'ա'
+		20: 1, // 'բ'
+		23: 2, // 'գ'
+		22: 2, // 'դ'
+		5:  0, // 'ե'
+		32: 2, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 2, // 'թ'
+		34: 1, // 'ժ'
+		3:  2, // 'ի'
+		8:  3, // 'լ'
+		25: 1, // 'խ'
+		26: 2, // 'ծ'
+		12: 3, // 'կ'
+		14: 1, // 'հ'
+		37: 0, // 'ձ'
+		18: 3, // 'ղ'
+		38: 0, // 'ճ'
+		9:  3, // 'մ'
+		11: 3, // 'յ'
+		2:  3, // 'ն'
+		29: 1, // 'շ'
+		1:  2, // 'ո'
+		21: 0, // 'չ'
+		15: 1, // 'պ'
+		27: 2, // 'ջ'
+		33: 2, // 'ռ'
+		13: 3, // 'ս'
+		10: 1, // 'վ'
+		7:  3, // 'տ'
+		4:  3, // 'ր'
+		19: 3, // 'ց'
+		6:  1, // 'ւ'
+		31: 1, // 'փ'
+		28: 3, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	32: { // 'զ'
+		0:  2, // 'ա'
+		20: 2, // 'բ'
+		23: 1, // 'գ'
+		22: 0, // 'դ'
+		5:  1, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  2, // 'մ'
+		11: 0, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  2, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 1, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 0, // 'վ'
+		7:  0, // 'տ'
+		4:  1, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	24: { // 'է'
+		0:  0, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  0, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  1, // 'ի'
+		8:  1, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 2, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  1, // 'մ'
+		11: 0, // 'յ'
+		2:  1, // 'ն'
+		29: 0, // 'շ'
+		1:  0, // 'ո'
+		21: 1, // 'չ'
+		15: 0, // 'պ'
+		27: 2, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  2, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	17: { // 'ը'
+		0:  0, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  0, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 1, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  0, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 0, // 'յ'
+		2:  3, // 'ն'
+		29: 0, // 'շ'
+		1:  0, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 0, // 'վ'
+		7:  0, // 'տ'
+		4:  0, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	16: { // 'թ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 2, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 3, // 'յ'
+		2:  1, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  0, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	34: { // 'ժ'
+		0:  2, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  1, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 0, // 'յ'
+		2:  0, // 'ն'
+		29: 0, // 'շ'
+		1:  2, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  0, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	3: { // 'ի'
+		0:  3, // 'ա'
+		20: 3, // 'բ'
+		23: 2, // 'գ'
+		22: 2, // 'դ'
+		5:  2, // 'ե'
+		32: 2, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 1, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  2, // 'լ'
+		25: 1, // 'խ'
+		26: 1, // 'ծ'
+		12: 3, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 1, // 'ճ'
+		9:  2, // 'մ'
+		11: 0, // 'յ'
+		2:  3, // 'ն'
+		29: 3, // 'շ'
+		1:  2, // 'ո'
+		21: 2, // 'չ'
+		15: 3, // 'պ'
+		27: 2, // 'ջ'
+		33: 1, // 'ռ'
+		13: 3, // 'ս'
+		10: 2, // 'վ'
+		7:  2, // 'տ'
+		4:  3, // 'ր'
+		19: 3, // 'ց'
+		6:  1, // 'ւ'
+		31: 0, // 'փ'
+		28: 2, // 'ք'
+		36: 0, // 'օ'
+		30: 1, // 'ֆ'
+		35: 1, // 'և'
+	},
+	8: { // 'լ'
+		0:  3, // 'ա'
+		20: 1, // 'բ'
+		23: 1, // 'գ'
+		22: 2, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 1, // 'է'
+		17: 3, // 'ը'
+		16: 1, // 'թ'
+		34: 1, // 'ժ'
+		3:  3, // 'ի'
+		8:  1, // 'լ'
+		25: 2, // 'խ'
+		26: 0, // 'ծ'
+		12: 1, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 1, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 2, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 1, // 'ռ'
+		13: 1, // 'ս'
+		10: 1, // 'վ'
+		7:  1, // 'տ'
+		4:  2, // 'ր'
+		19: 1, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 2, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	25: { // 'խ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  1, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  2, // 'մ'
+		11: 0, // 'յ'
+		2:  0, // 'ն'
+		29: 0, // 'շ'
+		1:  2, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 1, // 'վ'
+		7:  1, // 'տ'
+		4:  1, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	26: { // 'ծ'
+		0:  2, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 1, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 1, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  2, // 'մ'
+		11: 0, // 'յ'
+		2:  1, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 2, // 'վ'
+		7:  0, // 'տ'
+		4:  2, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 2, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	12: { // 'կ'
+		0:  3, // 'ա'
+		20: 1, // 'բ'
+		23: 0, // 'գ'
+		22: 1, // 'դ'
+		5:  3, // 'ե'
+		32: 2, // 'զ'
+		24: 0, // 'է'
+		17: 2, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  1, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 1, // 'հ'
+		37: 0, // 'ձ'
+		18: 3, // 'ղ'
+		38: 0, // 'ճ'
+		9:  2, // 'մ'
+		11: 2, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 1, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 2, // 'ս'
+		10: 2, // 'վ'
+		7:  3, // 'տ'
+		4:  3, // 'ր'
+		19: 3, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	14: { // 'հ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 1, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  1, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 2, // 'ղ'
+		38: 0, // 'ճ'
+		9:  3, // 'մ'
+		11: 1, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 0, // 'վ'
+		7:  0, // 'տ'
+		4:  2, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	37: { // 'ձ'
+		0:  2, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  1, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  1, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  1, // 'մ'
+		11: 0, // 'յ'
+		2:  1, // 'ն'
+		29: 0, // 'շ'
+		1:  0, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  0, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 2, // 'և'
+	},
+	18: { // 'ղ'
+		0:  3, // 'ա'
+		20: 2, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 3, // 'զ'
+		24: 0, // 'է'
+		17: 2, // 'ը'
+		16: 2, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 2, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 1, // 'ղ'
+		38: 0, // 'ճ'
+		9:  2, // 'մ'
+		11: 1, // 'յ'
+		2:  1, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 1, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 3, // 'վ'
+		7:  1, // 'տ'
+		4:  0, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 1, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	38: { // 'ճ'
+		0:  2, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  1, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 1, // 'յ'
+		2:  0, // 'ն'
+		29: 1, // 'շ'
+		1:  0, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 0, // 'վ'
+		7:  0, // 'տ'
+		4:  0, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	9: { // 'մ'
+		0:  3, // 'ա'
+		20: 3, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 1, // 'է'
+		17: 3, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 2, // 'յ'
+		2:  2, // 'ն'
+		29: 2, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 2, // 'վ'
+		7:  1, // 'տ'
+		4:  0, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	11: { // 'յ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 1, // 'գ'
+		22: 2, // 'դ'
+		5:  2, // 'ե'
+		32: 1, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 2, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  3, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 1, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  1, // 'մ'
+		11: 0, // 'յ'
+		2:  2, // 'ն'
+		29: 1, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 2, // 'ս'
+		10: 1, // 'վ'
+		7:  2, // 'տ'
+		4:  1, // 'ր'
+		19: 2, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 1, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	2: { // 'ն'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 3, // 'գ'
+		22: 3, // 'դ'
+		5:  3, // 'ե'
+		32: 1, // 'զ'
+		24: 1, // 'է'
+		17: 3, // 'ը'
+		16: 3, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  1, // 'լ'
+		25: 1, // 'խ'
+		26: 0, // 'ծ'
+		12: 3, // 'կ'
+		14: 2, // 'հ'
+		37: 0, // 'ձ'
+		18: 1, // 'ղ'
+		38: 2, // 'ճ'
+		9:  2, // 'մ'
+		11: 2, // 'յ'
+		2:  3, // 'ն'
+		29: 2, // 'շ'
+		1:  3, // 'ո'
+		21: 2, // 'չ'
+		15: 2, // 'պ'
+		27: 2, // 'ջ'
+		33: 0, // 'ռ'
+		13: 3, // 'ս'
+		10: 3, // 'վ'
+		7:  3, // 'տ'
+		4:  3, // 'ր'
+		19: 2, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 2, // 'ք'
+		36: 1, // 'օ'
+		30: 1, // 'ֆ'
+		35: 0, // 'և'
+	},
+	29: { // 'շ'
+		0:  3, // 'ա'
+		20: 1, // 'բ'
+		23: 1, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 2, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  0, // 'լ'
+		25: 2, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 0, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  2, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 2, // 'վ'
+		7:  2, // 'տ'
+		4:  1, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	1: { // 'ո'
+		0:  1, // 'ա'
+		20: 1, // 'բ'
+		23: 1, // 'գ'
+		22: 3, // 'դ'
+		5:  0, // 'ե'
+		32: 1, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  0, // 'ի'
+		8:  3, // 'լ'
+		25: 3, // 'խ'
+		26: 1, // 'ծ'
+		12: 3, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 3, // 'ղ'
+		38: 0, // 'ճ'
+		9:  2, // 'մ'
+		11: 3, // 'յ'
+		2:  3, // 'ն'
+		29: 2, // 'շ'
+		1:  0, // 'ո'
+		21: 2, // 'չ'
+		15: 1, // 'պ'
+		27: 1, // 'ջ'
+		33: 1, // 'ռ'
+		13: 3, // 'ս'
+		10: 3, // 'վ'
+		7:  2, // 'տ'
+		4:  3, // 'ր'
+		19: 3, // 'ց'
+		6:  3, // 'ւ'
+		31: 2, // 'փ'
+		28: 2, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	21: { // 'չ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 1, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 3, // 'է'
+		17: 1, // 'ը'
+		16: 1, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 1, // 'կ'
+		14: 2, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  1, // 'մ'
+		11: 1, // 'յ'
+		2:  1, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 2, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  1, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 1, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 1, // 'և'
+	},
+	15: { // 'պ'
+		0:  3, // 'ա'
+		20: 1, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 2, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  1, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  1, // 'մ'
+		11: 0, // 'յ'
+		2:  0, // 'ն'
+		29: 0, // 'շ'
+		1:  2, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 1, // 'վ'
+		7:  2, // 'տ'
+		4:  3, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	27: { // 'ջ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 1, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 0, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  1, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 1, // 'և'
+	},
+	33: { // 'ռ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 1, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  1, // 'ի'
+		8:  1, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 0, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  2, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  0, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 1, // 'և'
+	},
+	13: { // 'ս'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 2, // 'ը'
+		16: 2, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  2, // 'լ'
+		25: 3, // 'խ'
+		26: 0, // 'ծ'
+		12: 2, // 'կ'
+		14: 1, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 2, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 3, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 3, // 'վ'
+		7:  3, // 'տ'
+		4:  1, // 'ր'
+		19: 3, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 2, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	10: { // 'վ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 1, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  1, // 'մ'
+		11: 2, // 'յ'
+		2:  2, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 1, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 2, // 'վ'
+		7:  1, // 'տ'
+		4:  3, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	7: { // 'տ'
+		0:  3, // 'ա'
+		20: 1, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 3, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  3, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 2, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 1, // 'ղ'
+		38: 2, // 'ճ'
+		9:  1, // 'մ'
+		11: 1, // 'յ'
+		2:  3, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 1, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 3, // 'վ'
+		7:  0, // 'տ'
+		4:  3, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 3, // 'ք'
+		36: 0, // 'օ'
+		30: 1, // 'ֆ'
+		35: 2, // 'և'
+	},
+	4: { // 'ր'
+		0:  3, // 'ա'
+		20: 2, // 'բ'
+		23: 3, // 'գ'
+		22: 3, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 3, // 'ը'
+		16: 1, // 'թ'
+		34: 3, // 'ժ'
+		3:  3, // 'ի'
+		8:  2, // 'լ'
+		25: 0, // 'խ'
+		26: 2, // 'ծ'
+		12: 3, // 'կ'
+		14: 2, // 'հ'
+		37: 2, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  3, // 'մ'
+		11: 1, // 'յ'
+		2:  2, // 'ն'
+		29: 1, // 'շ'
+		1:  3, // 'ո'
+		21: 1, // 'չ'
+		15: 2, // 'պ'
+		27: 2, // 'ջ'
+		33: 0, // 'ռ'
+		13: 3, // 'ս'
+		10: 3, // 'վ'
+		7:  3, // 'տ'
+		4:  3, // 'ր'
+		19: 1, // 'ց'
+		6:  2, // 'ւ'
+		31: 0, // 'փ'
+		28: 2, // 'ք'
+		36: 0, // 'օ'
+		30: 2, // 'ֆ'
+		35: 2, // 'և'
+	},
+	19: { // 'ց'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  3, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 1, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  2, // 'մ'
+		11: 2, // 'յ'
+		2:  3, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 3, // 'վ'
+		7:  0, // 'տ'
+		4:  2, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 1, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	6: { // 'ւ'
+		0:  2, // 'ա'
+		20: 1, // 'բ'
+		23: 2, // 'գ'
+		22: 2, // 'դ'
+		5:  2, // 'ե'
+		32: 1, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 3, // 'թ'
+		34: 0, // 'ժ'
+		3:  1, // 'ի'
+		8:  1, // 'լ'
+		25: 0, // 'խ'
+		26: 2, // 'ծ'
+		12: 2, // 'կ'
+		14: 1, // 'հ'
+		37: 0, // 'ձ'
+		18: 3, // 'ղ'
+		38: 0, // 'ճ'
+		9:  3, // 'մ'
+		11: 3, // 'յ'
+		2:  3, // 'ն'
+		29: 2, // 'շ'
+		1:  0, // 'ո'
+		21: 1, // 'չ'
+		15: 1, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 2, // 'ս'
+		10: 1, // 'վ'
+		7:  3, // 'տ'
+		4:  3, // 'ր'
+		19: 2, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 1, // 'ք'
+		36: 0, // 'օ'
+		30: 1, // 'ֆ'
+		35: 0, // 'և'
+	},
+	31: { // 'փ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  1, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 1, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  0, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 0, // 'յ'
+		2:  0, // 'ն'
+		29: 0, // 'շ'
+		1:  3, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 0, // 'վ'
+		7:  1, // 'տ'
+		4:  1, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	28: { // 'ք'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 3, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  1, // 'մ'
+		11: 0, // 'յ'
+		2:  2, // 'ն'
+		29: 1, // 'շ'
+		1:  2, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 3, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  1, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	36: { // 'օ'
+		0:  0, // 'ա'
+		20: 2, // 'բ'
+		23: 2, // 'գ'
+		22: 0, // 'դ'
+		5:  0, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  0, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 1, // 'հ'
+		37: 0, // 'ձ'
+		18: 1, // 'ղ'
+		38: 0, // 'ճ'
+		9:  1, // 'մ'
+		11: 0, // 'յ'
+		2:  0, // 'ն'
+		29: 0, // 'շ'
+		1:  0, // 'ո'
+		21: 0, // 'չ'
+		15: 1, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 1, // 'վ'
+		7:  0, // 'տ'
+		4:  1, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	30: { // 'ֆ'
+		0:  3, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 0, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  2, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 1, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 0, // 'յ'
+		2:  0, // 'ն'
+		29: 0, // 'շ'
+		1:  2, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 0, // 'ս'
+		10: 0, // 'վ'
+		7:  0, // 'տ'
+		4:  2, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+	35: { // 'և'
+		0:  1, // 'ա'
+		20: 0, // 'բ'
+		23: 0, // 'գ'
+		22: 0, // 'դ'
+		5:  2, // 'ե'
+		32: 0, // 'զ'
+		24: 1, // 'է'
+		17: 0, // 'ը'
+		16: 0, // 'թ'
+		34: 0, // 'ժ'
+		3:  0, // 'ի'
+		8:  0, // 'լ'
+		25: 0, // 'խ'
+		26: 0, // 'ծ'
+		12: 0, // 'կ'
+		14: 0, // 'հ'
+		37: 0, // 'ձ'
+		18: 0, // 'ղ'
+		38: 0, // 'ճ'
+		9:  0, // 'մ'
+		11: 1, // 'յ'
+		2:  1, // 'ն'
+		29: 0, // 'շ'
+		1:  1, // 'ո'
+		21: 0, // 'չ'
+		15: 0, // 'պ'
+		27: 0, // 'ջ'
+		33: 0, // 'ռ'
+		13: 1, // 'ս'
+		10: 0, // 'վ'
+		7:  0, // 'տ'
+		4:  0, // 'ր'
+		19: 0, // 'ց'
+		6:  0, // 'ւ'
+		31: 0, // 'փ'
+		28: 0, // 'ք'
+		36: 0, // 'օ'
+		30: 0, // 'ֆ'
+		35: 0, // 'և'
+	},
+}
+
+func NewArmscii8ArmenianModel() *SingleByteCharSetModel {
+	return &SingleByteCharSetModel{
+		CharsetName: consts.Armscii8,
+		Language:    consts.Armenian,
+		CharToOrderMap: [256]int{
+			0:   255, // '\x00'
+			1:   255, // '\x01'
+			2:   255, // '\x02'
+			3:   255, // '\x03'
+			4:   255, // '\x04'
+			5:   255, // '\x05'
+			6:   255, // '\x06'
+			7:   255, // '\x07'
+			8:   255, // '\x08'
+			9:   255, // '\t'
+			10:  254, // '\n'
+			11:  255, // '\x0b'
+			12:  255, // '\x0c'
+			13:  254, // '\r'
+			14:  255, // '\x0e'
+			15:  255, // '\x0f'
+			16:  255, // '\x10'
+			17:  255, // '\x11'
+			18:  255, // '\x12'
+			19:  255, // '\x13'
+			20:  255, // '\x14'
+			21:  255, // '\x15'
+			22:  255, // '\x16'
+			23:  255, // '\x17'
+			24:  255, // '\x18'
+			25:  255, // '\x19'
+			26:  255, // '\x1a'
+			27:  255, // '\x1b'
+			28:  255, // '\x1c'
+			29:  255, // '\x1d'
+			30:  255, // '\x1e'
+			31:  255, // '\x1f'
+			32:  253, // ' '
+			33:  253, // '!'
+			34:  253, // '"'
+			35:  253, // '#'
+			36:  253, // '$'
+			37:  253, // '%'
+			38:  253, // '&'
+			39:  253, // "'"
+			40:  253, // '('
+			41:  253, // ')'
+			42:  253, // '*'
+			43:  253, // '+'
+			44:  253, // ','
+			45:  253, // '-'
+			46:  253, // '.'
+			47:  253, // '/'
+			48:  252, // '0'
+			49:  252, // '1'
+			50:  252, // '2'
+			51:  252, // '3'
+			52:  252, // '4'
+			53:  252, // '5'
+			54:  252, // '6'
+			55:  252, // '7'
+			56:  252, // '8'
+			57:  252, // '9'
+			58:  253, // ':'
+			59:  253, // ';'
+			60:  253, // '<'
+			61:  253, // '='
+			62:  253, // '>'
+			63:  253, // '?'
+			64:  253, // '@'
+			65:  64,  // 'A'
+			66:  65,  // 'B'
+			67:  66,  // 'C'
+			68:  67,  // 'D'
+			69:  68,  // 'E'
+			70:  69,  // 'F'
+			71:  70,  // 'G'
+			72:  71,  // 'H'
+			73:  72,  // 'I'
+			74:  73,  // 'J'
+			75:  74,  // 'K'
+			76:  75,  // 'L'
+			77:  76,  // 'M'
+			78:  77,  // 'N'
+			79:  78,  // 'O'
+			80:  79,  // 'P'
+			81:  80,  // 'Q'
+			82:  81,  // 'R'
+			83:  82,  // 'S'
+			84:  83,  // 'T'
+			85:  84,  // 'U'
+			86:  85,  // 'V'
+			87:  86,  // 'W'
+			88:  87,  // 'X'
+			89:  88,  // 'Y'
+			90:  89,  // 'Z'
+			91:  253, // '['
+			92:  253, // '\\'
+			93:  253, // ']'
+			94:  253, // '^'
+			95:  253, // '_'
+			96:  253, // '`'
+			97:  90,  // 'a'
+			98:  91,  // 'b'
+			99:  92,  // 'c'
+			100: 93,  // 'd'
+			101: 94,  // 'e'
+			102: 95,  // 'f'
+			103: 96,  // 'g'
+			104: 97,  // 'h'
+			105: 98,  // 'i'
+			106: 99,  // 'j'
+			107: 100, // 'k'
+			108: 101, // 'l'
+			109: 102, // 'm'
+			110: 103, // 'n'
+			111: 104, // 'o'
+			112: 105, // 'p'
+			113: 106, // 'q'
+			114: 107, // 'r'
+			115: 108, // 's'
+			116: 109, // 't'
+			117: 110, // 'u'
+			118: 111, // 'v'
+			119: 112, // 'w'
+			120: 113, // 'x'
+			121: 114, // 'y'
+			122: 115, // 'z'
+			123: 253, // '{'
+			124: 253, // '|'
+			125: 253, // '}'
+			126: 253, // '~'
+			127: 255, // '\x7f'
+			128: 116, // '\x80'
+			129: 117, // '\x81'
+			130: 118, // '\x82'
+			131: 119, // '\x83'
+			132: 120, // '\x84'
+			133: 121, // '\x85'
+			134: 122, // '\x86'
+			135: 123, // '\x87'
+			136: 124, // '\x88'
+			137: 125, // '\x89'
+			138: 126, // '\x8a'
+			139: 127, // '\x8b'
+			140: 128, // '\x8c'
+			141: 129, // '\x8d'
+			142: 130, // '\x8e'
+			143: 131, // '\x8f'
+			144: 132, // '\x90'
+			145: 133, // '\x91'
+			146: 134, // '\x92'
+			147: 135, // '\x93'
+			148: 136, // '\x94'
+			149: 137, // '\x95'
+			150: 138, // '\x96'
+			151: 139, // '\x97'
+			152: 140, // '\x98'
+			153: 141, // '\x99'
+			154: 142, // '\x9a'
+			155: 143, // '\x9b'
+			156: 144, // '\x9c'
+			157: 145, // '\x9d'
+			158: 146, // '\x9e'
+			159: 147, // '\x9f'
+			160: 148, // '\xa0'
+			161: 255, // '\xa1'
+			162: 35,  // 'և'
+			163: 149, // '։'
+			164: 253, // ')'
+			165: 253, // '('
+			166: 150, // '»'
+			167: 151, // '«'
+			168: 152, // '—'
+			169: 253, // '.'
+			170: 153, // '՝'
+			171: 253, // ','
+			172: 253, // '-'
+			173: 154, // '֊'
+			174: 155, // '…'
+			175: 156, // '՜'
+			176: 157, // '՛'
+			177: 158, // '՞'
+			178: 0,   // 'Ա'
+			179: 0,   // 'ա'
+			180: 20,  // 'Բ'
+			181: 20,  // 'բ'
+			182: 23,  // 'Գ'
+			183: 23,  // 'գ'
+			184: 22,  // 'Դ'
+			185: 22,  // 'դ'
+			186: 5,   // 'Ե'
+			187: 5,   // 'ե'
+			188: 32,  // 'Զ'
+			189: 32,  // 'զ'
+			190: 24,  // 'Է'
+			191: 24,  // 'է'
+			192: 17,  // 'Ը'
+			193: 17,  // 'ը'
+			194: 16,  // 'Թ'
+			195: 16,  // 'թ'
+			196: 34,  // 'Ժ'
+			197: 34,  // 'ժ'
+			198: 3,   // 'Ի'
+			199: 3,   // 'ի'
+			200: 8,   // 'Լ'
+			201: 8,   // 'լ'
+			202: 25,  // 'Խ'
+			203: 25,  // 'խ'
+			204: 26,  // 'Ծ'
+			205: 26,  // 'ծ'
+			206: 12,  // 'Կ'
+			207: 12,  // 'կ'
+			208: 14,  // 'Հ'
+			209: 14,  // 'հ'
+			210: 37,  // 'Ձ'
+			211: 37,  // 'ձ'
+			212: 18,  // 'Ղ'
+			213: 18,  // 'ղ'
+			214: 38,  // 'Ճ'
+			215: 38,  // 'ճ'
+			216: 9,   // 'Մ'
+			217: 9,   // 'մ'
+			218: 11,  // 'Յ'
+			219: 11,  // 'յ'
+			220: 2,   // 'Ն'
+			221: 2,   // 'ն'
+			222: 29,  // 'Շ'
+			223: 29,  // 'շ'
+			224: 1,   // 'Ո'
+			225: 1,   // 'ո'
+			226: 21,  // 'Չ'
+			227: 21,  // 'չ'
+			228: 15,  // 'Պ'
+			229: 15,  // 'պ'
+			230: 27,  // 'Ջ'
+			231: 27,  // 'ջ'
+			232: 33,  // 'Ռ'
+			233: 33,  // 'ռ'
+			234: 13,  // 'Ս'
+			235: 13,  // 'ս'
+			236: 10,  // 'Վ'
+			237: 10,  // 'վ'
+			238: 7,   // 'Տ'
+			239: 7,   // 'տ'
+			240: 4,   // 'Ր'
+			241: 4,   // 'ր'
+			242: 19,  // 'Ց'
+			243: 19,  // 'ց'
+			244: 6,   // 'Ւ'
+			245: 6,   // 'ւ'
+			246: 31,  // 'Փ'
+			247: 31,  // 'փ'
+			248: 28,  // 'Ք'
+			249: 28,  // 'ք'
+			250: 36,  // 'Օ'
+			251: 36,  // 'օ'
+			252: 30,  // 'Ֆ'
+			253: 30,  // 'ֆ'
+			254: 159, // '՚'
+			255: 255, // '\xff'
+		},
+		LanguageModel:        armenianLangModel,
+		TypicalPositiveRatio: 0.890770,
+		KeepAsciiLetters:     false,
+		Alphabet:             "ԱԲԳԴԵԶԷԸԹԺԻԼԽԾԿՀՁՂՃՄՅՆՇՈՉՊՋՌՍՎՏՐՑՒՓՔՕՖաբգդեզէըթժիլխծկհձղճմյնշոչպջռսվտրցւփքօֆև",
+	}
+}
@@ -0,0 +1,2155 @@
+// Code generated by gen_lang_model.go; DO NOT EDIT.
+
+// Trained on the Georgian translations shipped by Debian 12.12: the message
+// catalogs of Linux-PAM, PackageKit, AppStream, GLib, GnuTLS, gprof, grep,
+// GStreamer, libidn2, libpq, polkit, procps-ng, python-apt, sed, shadow,
+// shared-mime-info, software-properties, systemd and xkeyboard-config, and the
+// names of iso-codes 4.15.0 (iso_15924, iso_3166-1, iso_3166-2, iso_3166-3,
+// iso_4217, iso_639-2, iso_639-3 and iso_639-5).
+// The corpus holds 131852 letters and 113870 letter pairs.
+
+package probe
+
+import (
+	"github.com/wlynxg/chardet/consts"
+)
+
+var georgianLangModel = map[int]map[int]int{
+	0: { // 'ა'
+		0:  3, // 'ა'
+		8:  3, // 'ბ'
+		14: 3, // 'გ'
+		10: 3, // 'დ'
+		2:  2, // 'ე'
+		12: 3, // 'ვ'
+		24: 3, // 'ზ'
+		17: 2, // 'თ'
+		1:  3, // 'ი'
+		16: 3, // 'კ'
+		6:  3, // 'ლ'
+		7:  3, // 'მ'
+		9:  3, // 'ნ'
+		5:  2, // 'ო'
+		20: 3, // 'პ'
+		32: 1, // 'ჟ'
+		4:  3, // 'რ'
+		3:  3, // 'ს'
+		13: 3, // 'ტ'
+		11: 3, // 'უ'
+		21: 3, // 'ფ'
+		23: 3, // 'ქ'
+		27: 3, // 'ღ'
+		26: 3, // 'ყ'
+		15: 3, // 'შ'
+		25: 2, // 'ჩ'
+		18: 3, // 'ც'
+		28: 2, // 'ძ'
+		22: 3, // 'წ'
+		30: 3, // 'ჭ'
+		19: 3, // 'ხ'
+		29: 2, // 'ჯ'
+		31: 2, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	8: { // 'ბ'
+		0:  3, // 'ა'
+		8:  1, // 'ბ'
+		14: 1, // 'გ'
+		10: 0, // 'დ'
+		2:  3, // 'ე'
+		12: 1, // 'ვ'
+		24: 1, // 'ზ'
+		17: 1, // 'თ'
+		1:  3, // 'ი'
+		16: 0, // 'კ'
+		6:  3, // 'ლ'
+		7:  2, // 'მ'
+		9:  2, // 'ნ'
+		5:  3, // 'ო'
+		20: 1, // 'პ'
+		32: 0, // 'ჟ'
+		4:  3, // 'რ'
+		3:  3, // 'ს'
+		13: 1, // 'ტ'
+		11: 3, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 2, // 'შ'
+		25: 1, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 1, // 'ჭ'
+		19: 1, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	14: { // 'გ'
+		0:  3, // 'ა'
+		8:  1, // 'ბ'
+		14: 1, // 'გ'
+		10: 1, // 'დ'
+		2:  3, // 'ე'
+		12: 2, // 'ვ'
+		24: 2, // 'ზ'
+		17: 1, // 'თ'
+		1:  3, // 'ი'
+		16: 1, // 'კ'
+		6:  1, // 'ლ'
+		7:  1, // 'მ'
+		9:  2, // 'ნ'
+		5:  3, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  3, // 'რ'
+		3:  1, // 'ს'
+		13: 1, // 'ტ'
+		11: 3, // 'უ'
+		21: 1, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 1, // 'ჭ'
+		19: 1, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	10: { // 'დ'
+		0:  3, // 'ა'
+		8:  1, // 'ბ'
+		14: 2, // 'გ'
+		10: 1, // 'დ'
+		2:  3, // 'ე'
+		12: 2, // 'ვ'
+		24: 1, // 'ზ'
+		17: 0, // 'თ'
+		1:  3, // 'ი'
+		16: 0, // 'კ'
+		6:  1, // 'ლ'
+		7:  2, // 'მ'
+		9:  2, // 'ნ'
+		5:  3, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  2, // 'რ'
+		3:  1, // 'ს'
+		13: 0, // 'ტ'
+		11: 2, // 'უ'
+		21: 1, // 'ფ'
+		23: 0, // 'ქ'
+		27: 1, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 1, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	2: { // 'ე'
+		0:  2, // 'ა'
+		8:  3, // 'ბ'
+		14: 3, // 'გ'
+		10: 3, // 'დ'
+		2:  2, // 'ე'
+		12: 3, // 'ვ'
+		24: 2, // 'ზ'
+		17: 3, // 'თ'
+		1:  3, // 'ი'
+		16: 2, // 'კ'
+		6:  3, // 'ლ'
+		7:  3, // 'მ'
+		9:  3, // 'ნ'
+		5:  2, // 'ო'
+		20: 2, // 'პ'
+		32: 2, // 'ჟ'
+		4:  3, // 'რ'
+		3:  3, // 'ს'
+		13: 3, // 'ტ'
+		11: 3, // 'უ'
+		21: 2, // 'ფ'
+		23: 3, // 'ქ'
+		27: 0, // 'ღ'
+		26: 1, // 'ყ'
+		15: 2, // 'შ'
+		25: 1, // 'ჩ'
+		18: 3, // 'ც'
+		28: 1, // 'ძ'
+		22: 1, // 'წ'
+		30: 1, // 'ჭ'
+		19: 2, // 'ხ'
+		29: 1, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	12: { // 'ვ'
+		0:  3, // 'ა'
+		8:  0, // 'ბ'
+		14: 1, // 'გ'
+		10: 2, // 'დ'
+		2:  3, // 'ე'
+		12: 0, // 'ვ'
+		24: 1, // 'ზ'
+		17: 3, // 'თ'
+		1:  3, // 'ი'
+		16: 1, // 'კ'
+		6:  3, // 'ლ'
+		7:  1, // 'მ'
+		9:  3, // 'ნ'
+		5:  2, // 'ო'
+		20: 1, // 'პ'
+		32: 0, // 'ჟ'
+		4:  2, // 'რ'
+		3:  2, // 'ს'
+		13: 2, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 1, // 'ქ'
+		27: 1, // 'ღ'
+		26: 0, // 'ყ'
+		15: 2, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 1, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	24: { // 'ზ'
+		0:  2, // 'ა'
+		8:  1, // 'ბ'
+		14: 1, // 'გ'
+		10: 1, // 'დ'
+		2:  3, // 'ე'
+		12: 0, // 'ვ'
+		24: 1, // 'ზ'
+		17: 0, // 'თ'
+		1:  3, // 'ი'
+		16: 0, // 'კ'
+		6:  1, // 'ლ'
+		7:  1, // 'მ'
+		9:  1, // 'ნ'
+		5:  2, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  1, // 'ს'
+		13: 0, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 2, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 1, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	17: { // 'თ'
+		0:  3, // 'ა'
+		8:  1, // 'ბ'
+		14: 1, // 'გ'
+		10: 1, // 'დ'
+		2:  3, // 'ე'
+		12: 3, // 'ვ'
+		24: 1, // 'ზ'
+		17: 1, // 'თ'
+		1:  3, // 'ი'
+		16: 0, // 'კ'
+		6:  1, // 'ლ'
+		7:  1, // 'მ'
+		9:  1, // 'ნ'
+		5:  2, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  1, // 'ს'
+		13: 0, // 'ტ'
+		11: 2, // 'უ'
+		21: 0, // 'ფ'
+		23: 1, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 3, // 'ხ'
+		29: 1, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	1: { // 'ი'
+		0:  3, // 'ა'
+		8:  2, // 'ბ'
+		14: 2, // 'გ'
+		10: 3, // 'დ'
+		2:  3, // 'ე'
+		12: 2, // 'ვ'
+		24: 2, // 'ზ'
+		17: 3, // 'თ'
+		1:  3, // 'ი'
+		16: 3, // 'კ'
+		6:  3, // 'ლ'
+		7:  3, // 'მ'
+		9:  3, // 'ნ'
+		5:  3, // 'ო'
+		20: 2, // 'პ'
+		32: 1, // 'ჟ'
+		4:  3, // 'რ'
+		3:  3, // 'ს'
+		13: 3, // 'ტ'
+		11: 3, // 'უ'
+		21: 3, // 'ფ'
+		23: 2, // 'ქ'
+		27: 2, // 'ღ'
+		26: 2, // 'ყ'
+		15: 3, // 'შ'
+		25: 2, // 'ჩ'
+		18: 3, // 'ც'
+		28: 2, // 'ძ'
+		22: 2, // 'წ'
+		30: 1, // 'ჭ'
+		19: 2, // 'ხ'
+		29: 2, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	16: { // 'კ'
+		0:  3, // 'ა'
+		8:  1, // 'ბ'
+		14: 1, // 'გ'
+		10: 1, // 'დ'
+		2:  3, // 'ე'
+		12: 2, // 'ვ'
+		24: 1, // 'ზ'
+		17: 0, // 'თ'
+		1:  3, // 'ი'
+		16: 0, // 'კ'
+		6:  2, // 'ლ'
+		7:  2, // 'მ'
+		9:  1, // 'ნ'
+		5:  3, // 'ო'
+		20: 1, // 'პ'
+		32: 1, // 'ჟ'
+		4:  2, // 'რ'
+		3:  1, // 'ს'
+		13: 1, // 'ტ'
+		11: 3, // 'უ'
+		21: 1, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 1, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	6: { // 'ლ'
+		0:  3, // 'ა'
+		8:  2, // 'ბ'
+		14: 2, // 'გ'
+		10: 3, // 'დ'
+		2:  3, // 'ე'
+		12: 1, // 'ვ'
+		24: 1, // 'ზ'
+		17: 1, // 'თ'
+		1:  3, // 'ი'
+		16: 1, // 'კ'
+		6:  1, // 'ლ'
+		7:  2, // 'მ'
+		9:  1, // 'ნ'
+		5:  3, // 'ო'
+		20: 1, // 'პ'
+		32: 1, // 'ჟ'
+		4:  0, // 'რ'
+		3:  2, // 'ს'
+		13: 2, // 'ტ'
+		11: 3, // 'უ'
+		21: 1, // 'ფ'
+		23: 1, // 'ქ'
+		27: 0, // 'ღ'
+		26: 1, // 'ყ'
+		15: 2, // 'შ'
+		25: 1, // 'ჩ'
+		18: 1, // 'ც'
+		28: 1, // 'ძ'
+		22: 1, // 'წ'
+		30: 0, // 'ჭ'
+		19: 1, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	7: { // 'მ'
+		0:  3, // 'ა'
+		8:  3, // 'ბ'
+		14: 1, // 'გ'
+		10: 3, // 'დ'
+		2:  3, // 'ე'
+		12: 1, // 'ვ'
+		24: 1, // 'ზ'
+		17: 2, // 'თ'
+		1:  3, // 'ი'
+		16: 1, // 'კ'
+		6:  1, // 'ლ'
+		7:  1, // 'მ'
+		9:  3, // 'ნ'
+		5:  3, // 'ო'
+		20: 2, // 'პ'
+		32: 1, // 'ჟ'
+		4:  2, // 'რ'
+		3:  2, // 'ს'
+		13: 1, // 'ტ'
+		11: 3, // 'უ'
+		21: 1, // 'ფ'
+		23: 1, // 'ქ'
+		27: 0, // 'ღ'
+		26: 1, // 'ყ'
+		15: 2, // 'შ'
+		25: 0, // 'ჩ'
+		18: 2, // 'ც'
+		28: 1, // 'ძ'
+		22: 2, // 'წ'
+		30: 1, // 'ჭ'
+		19: 3, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	9: { // 'ნ'
+		0:  3, // 'ა'
+		8:  1, // 'ბ'
+		14: 3, // 'გ'
+		10: 3, // 'დ'
+		2:  3, // 'ე'
+		12: 1, // 'ვ'
+		24: 2, // 'ზ'
+		17: 1, // 'თ'
+		1:  3, // 'ი'
+		16: 2, // 'კ'
+		6:  1, // 'ლ'
+		7:  1, // 'მ'
+		9:  1, // 'ნ'
+		5:  3, // 'ო'
+		20: 1, // 'პ'
+		32: 1, // 'ჟ'
+		4:  1, // 'რ'
+		3:  2, // 'ს'
+		13: 3, // 'ტ'
+		11: 3, // 'უ'
+		21: 2, // 'ფ'
+		23: 2, // 'ქ'
+		27: 1, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 1, // 'ჩ'
+		18: 1, // 'ც'
+		28: 2, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 1, // 'ხ'
+		29: 1, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	5: { // 'ო'
+		0:  2, // 'ა'
+		8:  3, // 'ბ'
+		14: 2, // 'გ'
+		10: 3, // 'დ'
+		2:  3, // 'ე'
+		12: 3, // 'ვ'
+		24: 2, // 'ზ'
+		17: 2, // 'თ'
+		1:  2, // 'ი'
+		16: 3, // 'კ'
+		6:  3, // 'ლ'
+		7:  3, // 'მ'
+		9:  3, // 'ნ'
+		5:  1, // 'ო'
+		20: 2, // 'პ'
+		32: 0, // 'ჟ'
+		4:  3, // 'რ'
+		3:  3, // 'ს'
+		13: 2, // 'ტ'
+		11: 2, // 'უ'
+		21: 2, // 'ფ'
+		23: 2, // 'ქ'
+		27: 1, // 'ღ'
+		26: 2, // 'ყ'
+		15: 1, // 'შ'
+		25: 1, // 'ჩ'
+		18: 2, // 'ც'
+		28: 1, // 'ძ'
+		22: 2, // 'წ'
+		30: 0, // 'ჭ'
+		19: 2, // 'ხ'
+		29: 1, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	20: { // 'პ'
+		0:  3, // 'ა'
+		8:  1, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  2, // 'ე'
+		12: 0, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  3, // 'ი'
+		16: 0, // 'კ'
+		6:  2, // 'ლ'
+		7:  1, // 'მ'
+		9:  0, // 'ნ'
+		5:  3, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  3, // 'რ'
+		3:  1, // 'ს'
+		13: 2, // 'ტ'
+		11: 3, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	32: { // 'ჟ'
+		0:  1, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  1, // 'ე'
+		12: 0, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  0, // 'ნ'
+		5:  1, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  0, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	4: { // 'რ'
+		0:  3, // 'ა'
+		8:  2, // 'ბ'
+		14: 2, // 'გ'
+		10: 3, // 'დ'
+		2:  3, // 'ე'
+		12: 3, // 'ვ'
+		24: 1, // 'ზ'
+		17: 3, // 'თ'
+		1:  3, // 'ი'
+		16: 2, // 'კ'
+		6:  2, // 'ლ'
+		7:  3, // 'მ'
+		9:  2, // 'ნ'
+		5:  3, // 'ო'
+		20: 1, // 'პ'
+		32: 1, // 'ჟ'
+		4:  1, // 'რ'
+		3:  3, // 'ს'
+		13: 3, // 'ტ'
+		11: 3, // 'უ'
+		21: 1, // 'ფ'
+		23: 2, // 'ქ'
+		27: 1, // 'ღ'
+		26: 1, // 'ყ'
+		15: 2, // 'შ'
+		25: 2, // 'ჩ'
+		18: 2, // 'ც'
+		28: 3, // 'ძ'
+		22: 1, // 'წ'
+		30: 1, // 'ჭ'
+		19: 2, // 'ხ'
+		29: 2, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	3: { // 'ს'
+		0:  3, // 'ა'
+		8:  1, // 'ბ'
+		14: 1, // 'გ'
+		10: 1, // 'დ'
+		2:  3, // 'ე'
+		12: 2, // 'ვ'
+		24: 1, // 'ზ'
+		17: 3, // 'თ'
+		1:  3, // 'ი'
+		16: 2, // 'კ'
+		6:  2, // 'ლ'
+		7:  2, // 'მ'
+		9:  2, // 'ნ'
+		5:  3, // 'ო'
+		20: 3, // 'პ'
+		32: 0, // 'ჟ'
+		4:  2, // 'რ'
+		3:  1, // 'ს'
+		13: 3, // 'ტ'
+		11: 3, // 'უ'
+		21: 1, // 'ფ'
+		23: 1, // 'ქ'
+		27: 1, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 0, // 'ჩ'
+		18: 2, // 'ც'
+		28: 0, // 'ძ'
+		22: 3, // 'წ'
+		30: 2, // 'ჭ'
+		19: 2, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	13: { // 'ტ'
+		0:  3, // 'ა'
+		8:  1, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  3, // 'ე'
+		12: 2, // 'ვ'
+		24: 1, // 'ზ'
+		17: 1, // 'თ'
+		1:  3, // 'ი'
+		16: 1, // 'კ'
+		6:  1, // 'ლ'
+		7:  1, // 'მ'
+		9:  1, // 'ნ'
+		5:  3, // 'ო'
+		20: 1, // 'პ'
+		32: 0, // 'ჟ'
+		4:  3, // 'რ'
+		3:  2, // 'ს'
+		13: 0, // 'ტ'
+		11: 3, // 'უ'
+		21: 1, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 2, // 'ყ'
+		15: 1, // 'შ'
+		25: 1, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	11: { // 'უ'
+		0:  3, // 'ა'
+		8:  3, // 'ბ'
+		14: 2, // 'გ'
+		10: 2, // 'დ'
+		2:  2, // 'ე'
+		12: 1, // 'ვ'
+		24: 2, // 'ზ'
+		17: 2, // 'თ'
+		1:  2, // 'ი'
+		16: 2, // 'კ'
+		6:  3, // 'ლ'
+		7:  3, // 'მ'
+		9:  3, // 'ნ'
+		5:  1, // 'ო'
+		20: 1, // 'პ'
+		32: 1, // 'ჟ'
+		4:  3, // 'რ'
+		3:  2, // 'ს'
+		13: 2, // 'ტ'
+		11: 1, // 'უ'
+		21: 3, // 'ფ'
+		23: 2, // 'ქ'
+		27: 1, // 'ღ'
+		26: 0, // 'ყ'
+		15: 2, // 'შ'
+		25: 1, // 'ჩ'
+		18: 2, // 'ც'
+		28: 2, // 'ძ'
+		22: 1, // 'წ'
+		30: 2, // 'ჭ'
+		19: 2, // 'ხ'
+		29: 1, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	21: { // 'ფ'
+		0:  3, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  2, // 'ე'
+		12: 2, // 'ვ'
+		24: 1, // 'ზ'
+		17: 1, // 'თ'
+		1:  3, // 'ი'
+		16: 0, // 'კ'
+		6:  2, // 'ლ'
+		7:  0, // 'მ'
+		9:  1, // 'ნ'
+		5:  3, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  2, // 'რ'
+		3:  2, // 'ს'
+		13: 1, // 'ტ'
+		11: 2, // 'უ'
+		21: 1, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 1, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	23: { // 'ქ'
+		0:  3, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  2, // 'ე'
+		12: 2, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 1, // 'კ'
+		6:  1, // 'ლ'
+		7:  2, // 'მ'
+		9:  1, // 'ნ'
+		5:  2, // 'ო'
+		20: 1, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  2, // 'ს'
+		13: 2, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 0, // 'ჩ'
+		18: 2, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	27: { // 'ღ'
+		0:  2, // 'ა'
+		8:  1, // 'ბ'
+		14: 0, // 'გ'
+		10: 1, // 'დ'
+		2:  3, // 'ე'
+		12: 1, // 'ვ'
+		24: 1, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 0, // 'კ'
+		6:  1, // 'ლ'
+		7:  2, // 'მ'
+		9:  0, // 'ნ'
+		5:  1, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 1, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	26: { // 'ყ'
+		0:  2, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 1, // 'დ'
+		2:  3, // 'ე'
+		12: 2, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 0, // 'კ'
+		6:  1, // 'ლ'
+		7:  1, // 'მ'
+		9:  1, // 'ნ'
+		5:  3, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	15: { // 'შ'
+		0:  3, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  3, // 'ე'
+		12: 3, // 'ვ'
+		24: 1, // 'ზ'
+		17: 1, // 'თ'
+		1:  3, // 'ი'
+		16: 1, // 'კ'
+		6:  2, // 'ლ'
+		7:  1, // 'მ'
+		9:  2, // 'ნ'
+		5:  2, // 'ო'
+		20: 1, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  0, // 'ს'
+		13: 2, // 'ტ'
+		11: 2, // 'უ'
+		21: 1, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 1, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 1, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	25: { // 'ჩ'
+		0:  3, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  2, // 'ე'
+		12: 2, // 'ვ'
+		24: 1, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 1, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  2, // 'ნ'
+		5:  1, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  2, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 1, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 1, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	18: { // 'ც'
+		0:  2, // 'ა'
+		8:  1, // 'ბ'
+		14: 0, // 'გ'
+		10: 3, // 'დ'
+		2:  3, // 'ე'
+		12: 3, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  3, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  1, // 'მ'
+		9:  2, // 'ნ'
+		5:  1, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  0, // 'ს'
+		13: 1, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 2, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	28: { // 'ძ'
+		0:  3, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  2, // 'ე'
+		12: 1, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 1, // 'კ'
+		6:  2, // 'ლ'
+		7:  0, // 'მ'
+		9:  1, // 'ნ'
+		5:  1, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 2, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	22: { // 'წ'
+		0:  3, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  3, // 'ე'
+		12: 2, // 'ვ'
+		24: 0, // 'ზ'
+		17: 1, // 'თ'
+		1:  2, // 'ი'
+		16: 1, // 'კ'
+		6:  1, // 'ლ'
+		7:  2, // 'მ'
+		9:  0, // 'ნ'
+		5:  3, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 2, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 1, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	30: { // 'ჭ'
+		0:  1, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 2, // 'დ'
+		2:  2, // 'ე'
+		12: 1, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  0, // 'ნ'
+		5:  1, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 0, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 1, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	19: { // 'ხ'
+		0:  3, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 2, // 'დ'
+		2:  3, // 'ე'
+		12: 3, // 'ვ'
+		24: 1, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 0, // 'კ'
+		6:  2, // 'ლ'
+		7:  3, // 'მ'
+		9:  1, // 'ნ'
+		5:  2, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  2, // 'რ'
+		3:  2, // 'ს'
+		13: 1, // 'ტ'
+		11: 2, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 1, // 'ყ'
+		15: 1, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	29: { // 'ჯ'
+		0:  2, // 'ა'
+		8:  0, // 'ბ'
+		14: 3, // 'გ'
+		10: 0, // 'დ'
+		2:  2, // 'ე'
+		12: 1, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  0, // 'ნ'
+		5:  1, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 1, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	31: { // 'ჰ'
+		0:  2, // 'ა'
+		8:  0, // 'ბ'
+		14: 1, // 'გ'
+		10: 1, // 'დ'
+		2:  2, // 'ე'
+		12: 1, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  2, // 'ი'
+		16: 0, // 'კ'
+		6:  1, // 'ლ'
+		7:  1, // 'მ'
+		9:  0, // 'ნ'
+		5:  2, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  1, // 'რ'
+		3:  0, // 'ს'
+		13: 1, // 'ტ'
+		11: 1, // 'უ'
+		21: 0, // 'ფ'
+		23: 1, // 'ქ'
+		27: 0, // 'ღ'
+		26: 1, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	33: { // 'ჱ'
+		0:  0, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  0, // 'ე'
+		12: 0, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  0, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  0, // 'ნ'
+		5:  0, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  0, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 0, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	34: { // 'ჲ'
+		0:  0, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  0, // 'ე'
+		12: 0, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  0, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  0, // 'ნ'
+		5:  0, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  0, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 0, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	35: { // 'ჳ'
+		0:  0, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  0, // 'ე'
+		12: 0, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  0, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  0, // 'ნ'
+		5:  0, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  0, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 0, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	36: { // 'ჴ'
+		0:  0, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  0, // 'ე'
+		12: 0, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  0, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  0, // 'ნ'
+		5:  0, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  0, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 0, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	37: { // 'ჵ'
+		0:  0, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  0, // 'ე'
+		12: 0, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  0, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  0, // 'ნ'
+		5:  0, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  0, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 0, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+	38: { // 'ჶ'
+		0:  0, // 'ა'
+		8:  0, // 'ბ'
+		14: 0, // 'გ'
+		10: 0, // 'დ'
+		2:  0, // 'ე'
+		12: 0, // 'ვ'
+		24: 0, // 'ზ'
+		17: 0, // 'თ'
+		1:  0, // 'ი'
+		16: 0, // 'კ'
+		6:  0, // 'ლ'
+		7:  0, // 'მ'
+		9:  0, // 'ნ'
+		5:  0, // 'ო'
+		20: 0, // 'პ'
+		32: 0, // 'ჟ'
+		4:  0, // 'რ'
+		3:  0, // 'ს'
+		13: 0, // 'ტ'
+		11: 0, // 'უ'
+		21: 0, // 'ფ'
+		23: 0, // 'ქ'
+		27: 0, // 'ღ'
+		26: 0, // 'ყ'
+		15: 0, // 'შ'
+		25: 0, // 'ჩ'
+		18: 0, // 'ც'
+		28: 0, // 'ძ'
+		22: 0, // 'წ'
+		30: 0, // 'ჭ'
+		19: 0, // 'ხ'
+		29: 0, // 'ჯ'
+		31: 0, // 'ჰ'
+		33: 0, // 'ჱ'
+		34: 0, // 'ჲ'
+		35: 0, // 'ჳ'
+		36: 0, // 'ჴ'
+		37: 0, // 'ჵ'
+		38: 0, // 'ჶ'
+	},
+}
+
+func NewGeorgianPSGeorgianModel() *SingleByteCharSetModel {
+	return &SingleByteCharSetModel{
+		CharsetName: consts.GeorgianPS,
+		Language:    consts.Georgian,
+		CharToOrderMap: [256]int{
+			0:   255, // '\x00'
+			1:   255, // '\x01'
+			2:   255, // '\x02'
+			3:   255, // '\x03'
+			4:   255, // '\x04'
+			5:   255, // '\x05'
+			6:   255, // '\x06'
+			7:   255, // '\x07'
+			8:   255, // '\x08'
+			9:   255, // '\t'
+			10:  254, // '\n'
+			11:  255, // '\x0b'
+			12:  255, // '\x0c'
+			13:  254, // '\r'
+			14:  255, // '\x0e'
+			15:  255, // '\x0f'
+			16:  255, // '\x10'
+			17:  255, // '\x11'
+			18:  255, // '\x12'
+			19:  255, // '\x13'
+			20:  255, // '\x14'
+			21:  255, // '\x15'
+			22:  255, // '\x16'
+			23:  255, // '\x17'
+			24:  255, // '\x18'
+			25:  255, // '\x19'
+			26:  255, // '\x1a'
+			27:  255, // '\x1b'
+			28:  255, // '\x1c'
+			29:  255, // '\x1d'
+			30:  255, // '\x1e'
+			31:  255, // '\x1f'
+			32:  253, // ' '
+			33:  253, // '!'
+			34:  253, // '"'
+			35:  253, // '#'
+			36:  253, // '$'
+			37:  253, // '%'
+			38:  253, // '&'
+			39:  253, // "'"
+			40:  253, // '('
+			41:  253, // ')'
+			42:  253, // '*'
+			43:  253, // '+'
+			44:  253, // ','
+			45:  253, // '-'
+			46:  253, // '.'
+			47:  253, // '/'
+			48:  252, // '0'
+			49:  252, // '1'
+			50:  252, // '2'
+			51:  252, // '3'
+			52:  252, // '4'
+			53:  252, // '5'
+			54:  252, // '6'
+			55:  252, // '7'
+			56:  252, // '8'
+			57:  252, // '9'
+			58:  253, // ':'
+			59:  253, // ';'
+			60:  253, // '<'
+			61:  253, // '='
+			62:  253, // '>'
+			63:  253, // '?'
+			64:  253, // '@'
+			65:  64,  // 'A'
+			66:  65,  // 'B'
+			67:  66,  // 'C'
+			68:  67,  // 'D'
+			69:  68,  // 'E'
+			70:  69,  // 'F'
+			71:  70,  // 'G'
+			72:  71,  // 'H'
+			73:  72,  // 'I'
+			74:  73,  // 'J'
+			75:  74,  // 'K'
+			76:  75,  // 'L'
+			77:  76,  // 'M'
+			78:  77,  // 'N'
+			79:  78,  // 'O'
+			80:  79,  // 'P'
+			81:  80,  // 'Q'
+			82:  81,  // 'R'
+			83:  82,  // 'S'
+			84:  83,  // 'T'
+			85:  84,  // 'U'
+			86:  85,  // 'V'
+			87:  86,  // 'W'
+			88:  87,  // 'X'
+			89:  88,  // 'Y'
+			90:  89,  // 'Z'
+			91:  253, // '['
+			92:  253, // '\\'
+			93:  253, // ']'
+			94:  253, // '^'
+			95:  253, // '_'
+			96:  253, // '`'
+			97:  90,  // 'a'
+			98:  91,  // 'b'
+			99:  92,  // 'c'
+			100: 93,  // 'd'
+			101: 94,  // 'e'
+			102: 95,  // 'f'
+			103: 96,  // 'g'
+			104: 97,  // 'h'
+			105: 98,  // 'i'
+			106: 99,  // 'j'
+			107: 100, // 'k'
+			108: 101, // 'l'
+			109: 102, // 'm'
+			110: 103, // 'n'
+			111: 104, // 'o'
+			112: 105, // 'p'
+			113: 106, // 'q'
+			114: 107, // 'r'
+			115: 108, // 's'
+			116: 109, // 't'
+			117: 110, // 'u'
+			118: 111, // 'v'
+			119: 112, // 'w'
+			120: 113, // 'x'
+			121: 114, // 'y'
+			122: 115, // 'z'
+			123: 253, // '{'
+			124: 253, // '|'
+			125: 253, // '}'
+			126: 253, // '~'
+			127: 255, // '\x7f'
+			128: 116, // '\x80'
+			129: 117, // '\x81'
+			130: 118, // '‚'
+			131: 119, // 'ƒ'
+			132: 120, // '„'
+			133: 121, // '…'
+			134: 122, // '†'
+			135: 123, // '‡'
+			136: 124, // 'ˆ'
+			137: 125, // '‰'
+			138: 126, // 'Š'
+			139: 127, // '‹'
+			140: 128, // 'Œ'
+			141: 129, // '\x8d'
+			142: 130, // '\x8e'
+			143: 131, // '\x8f'
+			144: 132, // '\x90'
+			145: 133, // '‘'
+			146: 134, // '’'
+			147: 135, // '“'
+			148: 136, // '”'
+			149: 137, // '•'
+			150: 138, // '–'
+			151: 139, // '—'
+			152: 140, // '˜'
+			153: 141, // '™'
+			154: 142, // 'š'
+			155: 143, // '›'
+			156: 144, // 'œ'
+			157: 145, // '\x9d'
+			158: 146, // '\x9e'
+			159: 147, // 'Ÿ'
+			160: 148, // '\xa0'
+			161: 149, // '¡'
+			162: 150, // '¢'
+			163: 151, // '£'
+			164: 152, // '¤'
+			165: 153, // '¥'
+			166: 154, // '¦'
+			167: 155, // '§'
+			168: 156, // '¨'
+			169: 157, // '©'
+			170: 158, // 'ª'
+			171: 159, // '«'
+			172: 160, // '¬'
+			173: 161, // '\xad'
+			174: 162, // '®'
+			175: 163, // '¯'
+			176: 164, // '°'
+			177: 165, // '±'
+			178: 166, // '²'
+			179: 167, // '³'
+			180: 168, // '´'
+			181: 169, // 'µ'
+			182: 170, // '¶'
+			183: 171, // '·'
+			184: 172, // '¸'
+			185: 173, // '¹'
+			186: 174, // 'º'
+			187: 175, // '»'
+			188: 176, // '¼'
+			189: 177, // '½'
+			190: 178, // '¾'
+			191: 179, // '¿'
+			192: 0,   // 'ა'
+			193: 8,   // 'ბ'
+			194: 14,  // 'გ'
+			195: 10,  // 'დ'
+			196: 2,   // 'ე'
+			197: 12,  // 'ვ'
+			198: 24,  // 'ზ'
+			199: 33,  // 'ჱ'
+			200: 17,  // 'თ'
+			201: 1,   // 'ი'
+			202: 16,  // 'კ'
+			203: 6,   // 'ლ'
+			204: 7,   // 'მ'
+			205: 9,   // 'ნ'
+			206: 34,  // 'ჲ'
+			207: 5,   // 'ო'
+			208: 20,  // 'პ'
+			209: 32,  // 'ჟ'
+			210: 4,   // 'რ'
+			211: 3,   // 'ს'
+			212: 13,  // 'ტ'
+			213: 35,  // 'ჳ'
+			214: 11,  // 'უ'
+			215: 21,  // 'ფ'
+			216: 23,  // 'ქ'
+			217: 27,  // 'ღ'
+			218: 26,  // 'ყ'
+			219: 15,  // 'შ'
+			220: 25,  // 'ჩ'
+			221: 18,  // 'ც'
+			222: 28,  // 'ძ'
+			223: 22,  // 'წ'
+			224: 30,  // 'ჭ'
+			225: 19,  // 'ხ'
+			226: 36,  // 'ჴ'
+			227: 29,  // 'ჯ'
+			228: 31,  // 'ჰ'
+			229: 37,  // 'ჵ'
+			230: 38,  // 'ჶ'
+			231: 180, // 'ç'
+			232: 181, // 'è'
+			233: 182, // 'é'
+			234: 183, // 'ê'
+			235: 184, // 'ë'
+			236: 185, // 'ì'
+			237: 186, // 'í'
+			238: 187, // 'î'
+			239: 188, // 'ï'
+			240: 189, // 'ð'
+			241: 190, // 'ñ'
+			242: 191, // 'ò'
+			243: 192, // 'ó'
+			244: 193, // 'ô'
+			245: 194, // 'õ'
+			246: 195, // 'ö'
+			247: 196, // '÷'
+			248: 197, // 'ø'
+			249: 198, // 'ù'
+			250: 199, // 'ú'
+			251: 200, // 'û'
+			252: 201, // 'ü'
+			253: 202, // 'ý'
+			254: 203, // 'þ'
+			255: 204, // 'ÿ'
+		},
+		LanguageModel:        georgianLangModel,
+		TypicalPositiveRatio: 0.878370,
+		KeepAsciiLetters:     false,
+		Alphabet:             "აბგდევზთიკლმნოპჟრსტუფქღყშჩცძწჭხჯჰჱჲჳჴჵჶ",
+	}
+}
+
+func NewGeorgianAcademyGeorgianModel() *SingleByteCharSetModel {
+	return &SingleByteCharSetModel{
+		CharsetName: consts.GeorgianAcademy,
+		Language:    consts.Georgian,
+		CharToOrderMap: [256]int{
+			0:   255, // '\x00'
+			1:   255, // '\x01'
+			2:   255, // '\x02'
+			3:   255, // '\x03'
+			4:   255, // '\x04'
+			5:   255, // '\x05'
+			6:   255, // '\x06'
+			7:   255, // '\x07'
+			8:   255, // '\x08'
+			9:   255, // '\t'
+			10:  254, // '\n'
+			11:  255, // '\x0b'
+			12:  255, // '\x0c'
+			13:  254, // '\r'
+			14:  255, // '\x0e'
+			15:  255, // '\x0f'
+			16:  255, // '\x10'
+			17:  255, // '\x11'
+			18:  255, // '\x12'
+			19:  255, // '\x13'
+			20:  255, // '\x14'
+			21:  255, // '\x15'
+			22:  255, // '\x16'
+			23:  255, // '\x17'
+			24:  255, // '\x18'
+			25:  255, // '\x19'
+			26:  255, // '\x1a'
+			27:  255, // '\x1b'
+			28:  255, // '\x1c'
+			29:  255, // '\x1d'
+			30:  255, // '\x1e'
+			31:  255, // '\x1f'
+			32:  253, // ' '
+			33:  253, // '!'
+			34:  253, // '"'
+			35:  253, // '#'
+			36:  253, // '$'
+			37:  253, // '%'
+			38:  253, // '&'
+			39:  253, // "'"
+			40:  253, // '('
+			41:  253, // ')'
+			42:  253, // '*'
+			43:  253, // '+'
+			44:  253, // ','
+			45:  253, // '-'
+			46:  253, // '.'
+			47:  253, // '/'
+			48:  252, // '0'
+			49:  252, // '1'
+			50:  252, // '2'
+			51:  252, // '3'
+			52:  252, // '4'
+			53:  252, // '5'
+			54:  252, // '6'
+			55:  252, // '7'
+			56:  252, // '8'
+			57:  252, // '9'
+			58:  253, // ':'
+			59:  253, // ';'
+			60:  253, // '<'
+			61:  253, // '='
+			62:  253, // '>'
+			63:  253, // '?'
+			64:  253, // '@'
+			65:  64,  // 'A'
+			66:  65,  // 'B'
+			67:  66,  // 'C'
+			68:  67,  // 'D'
+			69:  68,  // 'E'
+			70:  69,  // 'F'
+			71:  70,  // 'G'
+			72:  71,  // 'H'
+			73:  72,  // 'I'
+			74:  73,  // 'J'
+			75:  74,  // 'K'
+			76:  75,  // 'L'
+			77:  76,  // 'M'
+			78:  77,  // 'N'
+			79:  78,  // 'O'
+			80:  79,  // 'P'
+			81:  80,  // 'Q'
+			82:  81,  // 'R'
+			83:  82,  // 'S'
+			84:  83,  // 'T'
+			85:  84,  // 'U'
+			86:  85,  // 'V'
+			87:  86,  // 'W'
+			88:  87,  // 'X'
+			89:  88,  // 'Y'
+			90:  89,  // 'Z'
+			91:  253, // '['
+			92:  253, // '\\'
+			93:  253, // ']'
+			94:  253, // '^'
+			95:  253, // '_'
+			96:  253, // '`'
+			97:  90,  // 'a'
+			98:  91,  // 'b'
+			99:  92,  // 'c'
+			100: 93,  // 'd'
+			101: 94,  // 'e'
+			102: 95,  // 'f'
+			103: 96,  // 'g'
+			104: 97,  // 'h'
+			105: 98,  // 'i'
+			106: 99,  // 'j'
+			107: 100, // 'k'
+			108: 101, // 'l'
+			109: 102, // 'm'
+			110: 103, // 'n'
+			111: 104, // 'o'
+			112: 105, // 'p'
+			113: 106, // 'q'
+			114: 107, // 'r'
+			115: 108, // 's'
+			116: 109, // 't'
+			117: 110, // 'u'
+			118: 111, // 'v'
+			119: 112, // 'w'
+			120: 113, // 'x'
+			121: 114, // 'y'
+			122: 115, // 'z'
+			123: 253, // '{'
+			124: 253, // '|'
+			125: 253, // '}'
+			126: 253, // '~'
+			127: 255, // '\x7f'
+			128: 116, // '\x80'
+			129: 117, // '\x81'
+			130: 118, // '‚'
+			131: 119, // 'ƒ'
+			132: 120, // '„'
+			133: 121, // '…'
+			134: 122, // '†'
+			135: 123, // '‡'
+			136: 124, // 'ˆ'
+			137: 125, // '‰'
+			138: 126, // 'Š'
+			139: 127, // '‹'
+			140: 128, // 'Œ'
+			141: 129, // '\x8d'
+			142: 130, // '\x8e'
+			143: 131, // '\x8f'
+			144: 132, // '\x90'
+			145: 133, // '‘'
+			146: 134, // '’'
+			147: 135, // '“'
+			148: 136, // '”'
+			149: 137, // '•'
+			150: 138, // '–'
+			151: 139, // '—'
+			152: 140, // '˜'
+			153: 141, // '™'
+			154: 142, // 'š'
+			155: 143, // '›'
+			156: 144, // 'œ'
+			157: 145, // '\x9d'
+			158: 146, // '\x9e'
+			159: 147, // 'Ÿ'
+			160: 148, // '\xa0'
+			161: 149, // '¡'
+			162: 150, // '¢'
+			163: 151, // '£'
+			164: 152, // '¤'
+			165: 153, // '¥'
+			166: 154, // '¦'
+			167: 155, // '§'
+			168: 156, // '¨'
+			169: 157, // '©'
+			170: 158, // 'ª'
+			171: 159, // '«'
+			172: 160, // '¬'
+			173: 161, // '\xad'
+			174: 162, // '®'
+			175: 163, // '¯'
+			176: 164, // '°'
+			177: 165, // '±'
+			178: 166, // '²'
+			179: 167, // '³'
+			180: 168, // '´'
+			181: 169, // 'µ'
+			182: 170, // '¶'
+			183: 171, // '·'
+			184: 172, // '¸'
+			185: 173, // '¹'
+			186: 174, // 'º'
+			187: 175, // '»'
+			188: 176, // '¼'
+			189: 177, // '½'
+			190: 178, // '¾'
+			191: 179, // '¿'
+			192: 0,   // 'ა'
+			193: 8,   // 'ბ'
+			194: 14,  // 'გ'
+			195: 10,  // 'დ'
+			196: 2,   // 'ე'
+			197: 12,  // 'ვ'
+			198: 24,  // 'ზ'
+			199: 17,  // 'თ'
+			200: 1,   // 'ი'
+			201: 16,  // 'კ'
+			202: 6,   // 'ლ'
+			203: 7,   // 'მ'
+			204: 9,   // 'ნ'
+			205: 5,   // 'ო'
+			206: 20,  // 'პ'
+			207: 32,  // 'ჟ'
+			208: 4,   // 'რ'
+			209: 3,   // 'ს'
+			210: 13,  // 'ტ'
+			211: 11,  // 'უ'
+			212: 21,  // 'ფ'
+			213: 23,  // 'ქ'
+			214: 27,  // 'ღ'
+			215: 26,  // 'ყ'
+			216: 15,  // 'შ'
+			217: 25,  // 'ჩ'
+			218: 18,  // 'ც'
+			219: 28,  // 'ძ'
+			220: 22,  // 'წ'
+			221: 30,  // 'ჭ'
+			222: 19,  // 'ხ'
+			223: 29,  // 'ჯ'
+			224: 31,  // 'ჰ'
+			225: 33,  // 'ჱ'
+			226: 34,  // 'ჲ'
+			227: 35,  // 'ჳ'
+			228: 36,  // 'ჴ'
+			229: 37,  // 'ჵ'
+			230: 38,  // 'ჶ'
+			231: 180, // 'ç'
+			232: 181, // 'è'
+			233: 182, // 'é'
+			234: 183, // 'ê'
+			235: 184, // 'ë'
+			236: 185, // 'ì'
+			237: 186, // 'í'
+			238: 187, // 'î'
+			239: 188, // 'ï'
+			240: 189, // 'ð'
+			241: 190, // 'ñ'
+			242: 191, // 'ò'
+			243: 192, // 'ó'
+			244: 193, // 'ô'
+			245: 194, // 'õ'
+			246: 195, // 'ö'
+			247: 196, // '÷'
+			248: 197, // 'ø'
+			249: 198, // 'ù'
+			250: 199, // 'ú'
+			251: 200, // 'û'
+			252: 201, // 'ü'
+			253: 202, // 'ý'
+			254: 203, // 'þ'
+			255: 204, // 'ÿ'
+		},
+		LanguageModel:        georgianLangModel,
+		TypicalPositiveRatio: 0.878370,
+		KeepAsciiLetters:     false,
+		Alphabet:             "აბგდევზთიკლმნოპჟრსტუფქღყშჩცძწჭხჯჰჱჲჳჴჵჶ",
+	}
+}
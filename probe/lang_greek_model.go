@@ -4387,3 +4387,272 @@ func NewWindows1253GreekModel() *SingleByteCharSetModel {
 		Alphabet:             "ΆΈΉΊΌΎΏΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΣΤΥΦΧΨΩάέήίαβγδεζηθικλμνξοπρςστυφχψωόύώ",
 	}
 }
+
+func NewMacGreekGreekModel() *SingleByteCharSetModel {
+	return &SingleByteCharSetModel{
+		CharsetName: consts.MacGreek,
+		Language:    consts.Greek,
+		CharToOrderMap: [256]int{
+			0:   255, // '\x00'
+			1:   255, // '\x01'
+			2:   255, // '\x02'
+			3:   255, // '\x03'
+			4:   255, // '\x04'
+			5:   255, // '\x05'
+			6:   255, // '\x06'
+			7:   255, // '\x07'
+			8:   255, // '\x08'
+			9:   255, // '\t'
+			10:  254, // '\n'
+			11:  255, // '\x0b'
+			12:  255, // '\x0c'
+			13:  254, // '\r'
+			14:  255, // '\x0e'
+			15:  255, // '\x0f'
+			16:  255, // '\x10'
+			17:  255, // '\x11'
+			18:  255, // '\x12'
+			19:  255, // '\x13'
+			20:  255, // '\x14'
+			21:  255, // '\x15'
+			22:  255, // '\x16'
+			23:  255, // '\x17'
+			24:  255, // '\x18'
+			25:  255, // '\x19'
+			26:  255, // '\x1a'
+			27:  255, // '\x1b'
+			28:  255, // '\x1c'
+			29:  255, // '\x1d'
+			30:  255, // '\x1e'
+			31:  255, // '\x1f'
+			32:  253, // ' '
+			33:  253, // '!'
+			34:  253, // '"'
+			35:  253, // '#'
+			36:  253, // '$'
+			37:  253, // '%'
+			38:  253, // '&'
+			39:  253, // "'"
+			40:  253, // '('
+			41:  253, // ')'
+			42:  253, // '*'
+			43:  253, // '+'
+			44:  253, // ','
+			45:  253, // '-'
+			46:  253, // '.'
+			47:  253, // '/'
+			48:  252, // '0'
+			49:  252, // '1'
+			50:  252, // '2'
+			51:  252, // '3'
+			52:  252, // '4'
+			53:  252, // '5'
+			54:  252, // '6'
+			55:  252, // '7'
+			56:  252, // '8'
+			57:  252, // '9'
+			58:  253, // ':'
+			59:  253, // ';'
+			60:  253, // '<'
+			61:  253, // '='
+			62:  253, // '>'
+			63:  253, // '?'
+			64:  253, // '@'
+			65:  82,  // 'A'
+			66:  100, // 'B'
+			67:  104, // 'C'
+			68:  94,  // 'D'
+			69:  98,  // 'E'
+			70:  101, // 'F'
+			71:  116, // 'G'
+			72:  102, // 'H'
+			73:  111, // 'I'
+			74:  187, // 'J'
+			75:  117, // 'K'
+			76:  92,  // 'L'
+			77:  88,  // 'M'
+			78:  113, // 'N'
+			79:  85,  // 'O'
+			80:  79,  // 'P'
+			81:  118, // 'Q'
+			82:  105, // 'R'
+			83:  83,  // 'S'
+			84:  67,  // 'T'
+			85:  114, // 'U'
+			86:  119, // 'V'
+			87:  95,  // 'W'
+			88:  99,  // 'X'
+			89:  109, // 'Y'
+			90:  188, // 'Z'
+			91:  253, // '['
+			92:  253, // '\\'
+			93:  253, // ']'
+			94:  253, // '^'
+			95:  253, // '_'
+			96:  253, // '`'
+			97:  72,  // 'a'
+			98:  70,  // 'b'
+			99:  80,  // 'c'
+			100: 81,  // 'd'
+			101: 60,  // 'e'
+			102: 96,  // 'f'
+			103: 93,  // 'g'
+			104: 89,  // 'h'
+			105: 68,  // 'i'
+			106: 120, // 'j'
+			107: 97,  // 'k'
+			108: 77,  // 'l'
+			109: 86,  // 'm'
+			110: 69,  // 'n'
+			111: 55,  // 'o'
+			112: 78,  // 'p'
+			113: 115, // 'q'
+			114: 65,  // 'r'
+			115: 66,  // 's'
+			116: 58,  // 't'
+			117: 76,  // 'u'
+			118: 106, // 'v'
+			119: 103, // 'w'
+			120: 87,  // 'x'
+			121: 107, // 'y'
+			122: 112, // 'z'
+			123: 253, // '{'
+			124: 253, // '|'
+			125: 253, // '}'
+			126: 253, // '~'
+			127: 253, // '\x7f'
+			128: 248, // 'Ä'
+			129: 249, // '¹'
+			130: 250, // '²'
+			131: 250, // 'É'
+			132: 250, // '³'
+			133: 250, // 'Ö'
+			134: 250, // 'Ü'
+			135: 233, // '΅'
+			136: 250, // 'à'
+			137: 250, // 'â'
+			138: 250, // 'ä'
+			139: 247, // '΄'
+			140: 250, // '¨'
+			141: 250, // 'ç'
+			142: 250, // 'é'
+			143: 250, // 'è'
+			144: 250, // 'ê'
+			145: 250, // 'ë'
+			146: 250, // '£'
+			147: 250, // '™'
+			148: 250, // 'î'
+			149: 250, // 'ï'
+			150: 250, // '•'
+			151: 250, // '½'
+			152: 250, // '‰'
+			153: 250, // 'ô'
+			154: 250, // 'ö'
+			155: 250, // '¦'
+			156: 250, // '€'
+			157: 250, // 'ù'
+			158: 250, // 'û'
+			159: 250, // 'ü'
+			160: 250, // '†'
+			161: 43,  // 'Γ'
+			162: 41,  // 'Δ'
+			163: 52,  // 'Θ'
+			164: 53,  // 'Λ'
+			165: 59,  // 'Ξ'
+			166: 35,  // 'Π'
+			167: 250, // 'ß'
+			168: 250, // '®'
+			169: 250, // '©'
+			170: 37,  // 'Σ'
+			171: 120, // 'Ϊ'
+			172: 250, // '§'
+			173: 250, // '≠'
+			174: 250, // '°'
+			175: 36,  // '·'
+			176: 31,  // 'Α'
+			177: 250, // '±'
+			178: 250, // '≤'
+			179: 250, // '≥'
+			180: 250, // '¥'
+			181: 51,  // 'Β'
+			182: 34,  // 'Ε'
+			183: 91,  // 'Ζ'
+			184: 40,  // 'Η'
+			185: 47,  // 'Ι'
+			186: 44,  // 'Κ'
+			187: 38,  // 'Μ'
+			188: 56,  // 'Φ'
+			189: 121, // 'Ϋ'
+			190: 84,  // 'Ψ'
+			191: 57,  // 'Ω'
+			192: 17,  // 'ά'
+			193: 49,  // 'Ν'
+			194: 250, // '¬'
+			195: 39,  // 'Ο'
+			196: 48,  // 'Ρ'
+			197: 250, // '≈'
+			198: 33,  // 'Τ'
+			199: 250, // '«'
+			200: 250, // '»'
+			201: 250, // '…'
+			202: 250, // '\xa0'
+			203: 45,  // 'Υ'
+			204: 50,  // 'Χ'
+			205: 61,  // 'Ά'
+			206: 46,  // 'Έ'
+			207: 250, // 'œ'
+			208: 250, // '–'
+			209: 250, // '―'
+			210: 250, // '“'
+			211: 250, // '”'
+			212: 250, // '‘'
+			213: 250, // '’'
+			214: 250, // '÷'
+			215: 71,  // 'Ή'
+			216: 73,  // 'Ί'
+			217: 54,  // 'Ό'
+			218: 108, // 'Ύ'
+			219: 18,  // 'έ'
+			220: 22,  // 'ή'
+			221: 15,  // 'ί'
+			222: 19,  // 'ό'
+			223: 123, // 'Ώ'
+			224: 26,  // 'ύ'
+			225: 1,   // 'α'
+			226: 29,  // 'β'
+			227: 42,  // 'ψ'
+			228: 21,  // 'δ'
+			229: 3,   // 'ε'
+			230: 28,  // 'φ'
+			231: 20,  // 'γ'
+			232: 13,  // 'η'
+			233: 5,   // 'ι'
+			234: 30,  // 'ξ'
+			235: 11,  // 'κ'
+			236: 16,  // 'λ'
+			237: 10,  // 'μ'
+			238: 6,   // 'ν'
+			239: 4,   // 'ο'
+			240: 9,   // 'π'
+			241: 27,  // 'ώ'
+			242: 8,   // 'ρ'
+			243: 7,   // 'σ'
+			244: 2,   // 'τ'
+			245: 25,  // 'θ'
+			246: 24,  // 'ω'
+			247: 14,  // 'ς'
+			248: 23,  // 'χ'
+			249: 12,  // 'υ'
+			250: 32,  // 'ζ'
+			251: 64,  // 'ϊ'
+			252: 75,  // 'ϋ'
+			253: 110, // 'ΐ'
+			254: 124, // 'ΰ'
+			255: 74,  // '\xad'
+		},
+		LanguageModel:        greekLangModel,
+		TypicalPositiveRatio: 0.982851,
+		KeepAsciiLetters:     false,
+		Alphabet:             "ΆΈΉΊΌΎΏΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΣΤΥΦΧΨΩάέήίαβγδεζηθικλμνξοπρςστυφχψωόύώ",
+	}
+}
@@ -4379,3 +4379,272 @@ func NewWindows1255HebrewModel() *SingleByteCharSetModel {
 		Alphabet:             "אבגדהוזחטיךכלםמןנסעףפץצקרשתװױײ",
 	}
 }
+
+func NewMacHebrewHebrewModel() *SingleByteCharSetModel {
+	return &SingleByteCharSetModel{
+		CharsetName: consts.MacHebrew,
+		Language:    consts.Hebrew,
+		CharToOrderMap: [256]int{
+			0:   255, // '\x00'
+			1:   255, // '\x01'
+			2:   255, // '\x02'
+			3:   255, // '\x03'
+			4:   255, // '\x04'
+			5:   255, // '\x05'
+			6:   255, // '\x06'
+			7:   255, // '\x07'
+			8:   255, // '\x08'
+			9:   255, // '\t'
+			10:  254, // '\n'
+			11:  255, // '\x0b'
+			12:  255, // '\x0c'
+			13:  254, // '\r'
+			14:  255, // '\x0e'
+			15:  255, // '\x0f'
+			16:  255, // '\x10'
+			17:  255, // '\x11'
+			18:  255, // '\x12'
+			19:  255, // '\x13'
+			20:  255, // '\x14'
+			21:  255, // '\x15'
+			22:  255, // '\x16'
+			23:  255, // '\x17'
+			24:  255, // '\x18'
+			25:  255, // '\x19'
+			26:  255, // '\x1a'
+			27:  255, // '\x1b'
+			28:  255, // '\x1c'
+			29:  255, // '\x1d'
+			30:  255, // '\x1e'
+			31:  255, // '\x1f'
+			32:  253, // ' '
+			33:  253, // '!'
+			34:  253, // '"'
+			35:  253, // '#'
+			36:  253, // '$'
+			37:  253, // '%'
+			38:  253, // '&'
+			39:  253, // "'"
+			40:  253, // '('
+			41:  253, // ')'
+			42:  253, // '*'
+			43:  253, // '+'
+			44:  253, // ','
+			45:  253, // '-'
+			46:  253, // '.'
+			47:  253, // '/'
+			48:  252, // '0'
+			49:  252, // '1'
+			50:  252, // '2'
+			51:  252, // '3'
+			52:  252, // '4'
+			53:  252, // '5'
+			54:  252, // '6'
+			55:  252, // '7'
+			56:  252, // '8'
+			57:  252, // '9'
+			58:  253, // ':'
+			59:  253, // ';'
+			60:  253, // '<'
+			61:  253, // '='
+			62:  253, // '>'
+			63:  253, // '?'
+			64:  253, // '@'
+			65:  69,  // 'A'
+			66:  91,  // 'B'
+			67:  79,  // 'C'
+			68:  80,  // 'D'
+			69:  92,  // 'E'
+			70:  89,  // 'F'
+			71:  97,  // 'G'
+			72:  90,  // 'H'
+			73:  68,  // 'I'
+			74:  111, // 'J'
+			75:  112, // 'K'
+			76:  82,  // 'L'
+			77:  73,  // 'M'
+			78:  95,  // 'N'
+			79:  85,  // 'O'
+			80:  78,  // 'P'
+			81:  121, // 'Q'
+			82:  86,  // 'R'
+			83:  71,  // 'S'
+			84:  67,  // 'T'
+			85:  102, // 'U'
+			86:  107, // 'V'
+			87:  84,  // 'W'
+			88:  114, // 'X'
+			89:  103, // 'Y'
+			90:  115, // 'Z'
+			91:  253, // '['
+			92:  253, // '\\'
+			93:  253, // ']'
+			94:  253, // '^'
+			95:  253, // '_'
+			96:  253, // '`'
+			97:  50,  // 'a'
+			98:  74,  // 'b'
+			99:  60,  // 'c'
+			100: 61,  // 'd'
+			101: 42,  // 'e'
+			102: 76,  // 'f'
+			103: 70,  // 'g'
+			104: 64,  // 'h'
+			105: 53,  // 'i'
+			106: 105, // 'j'
+			107: 93,  // 'k'
+			108: 56,  // 'l'
+			109: 65,  // 'm'
+			110: 54,  // 'n'
+			111: 49,  // 'o'
+			112: 66,  // 'p'
+			113: 110, // 'q'
+			114: 51,  // 'r'
+			115: 43,  // 's'
+			116: 44,  // 't'
+			117: 63,  // 'u'
+			118: 81,  // 'v'
+			119: 77,  // 'w'
+			120: 98,  // 'x'
+			121: 75,  // 'y'
+			122: 108, // 'z'
+			123: 253, // '{'
+			124: 253, // '|'
+			125: 253, // '}'
+			126: 253, // '~'
+			127: 253, // '\x7f'
+			128: 244, // 'Ä'
+			129: 245, // 'ײַ'
+			130: 246, // 'Ç'
+			131: 247, // 'É'
+			132: 248, // 'Ñ'
+			133: 249, // 'Ö'
+			134: 250, // 'Ü'
+			135: 250, // 'á'
+			136: 250, // 'à'
+			137: 250, // 'â'
+			138: 250, // 'ä'
+			139: 250, // 'ã'
+			140: 250, // 'å'
+			141: 250, // 'ç'
+			142: 250, // 'é'
+			143: 250, // 'è'
+			144: 250, // 'ê'
+			145: 250, // 'ë'
+			146: 250, // 'í'
+			147: 250, // 'ì'
+			148: 250, // 'î'
+			149: 250, // 'ï'
+			150: 250, // 'ñ'
+			151: 250, // 'ó'
+			152: 250, // 'ò'
+			153: 250, // 'ô'
+			154: 250, // 'ö'
+			155: 250, // 'õ'
+			156: 250, // 'ú'
+			157: 250, // 'ù'
+			158: 250, // 'û'
+			159: 250, // 'ü'
+			160: 250, // ' '
+			161: 250, // '!'
+			162: 250, // '"'
+			163: 250, // '#'
+			164: 250, // '$'
+			165: 250, // '%'
+			166: 100, // '₪'
+			167: 250, // "'"
+			168: 250, // '('
+			169: 250, // ')'
+			170: 250, // '*'
+			171: 250, // '+'
+			172: 250, // ','
+			173: 250, // '-'
+			174: 250, // '.'
+			175: 250, // '/'
+			176: 250, // '0'
+			177: 250, // '1'
+			178: 250, // '2'
+			179: 250, // '3'
+			180: 250, // '4'
+			181: 250, // '5'
+			182: 250, // '6'
+			183: 250, // '7'
+			184: 250, // '8'
+			185: 250, // '9'
+			186: 250, // ':'
+			187: 250, // ';'
+			188: 250, // '<'
+			189: 250, // '='
+			190: 250, // '>'
+			191: 250, // '?'
+			192: 255, // '\xc0'
+			193: 205, // '„'
+			194: 255, // '\xc2'
+			195: 255, // '\xc3'
+			196: 255, // '\xc4'
+			197: 255, // '\xc5'
+			198: 28,  // 'ּ'
+			199: 250, // 'וֹ'
+			200: 250, // 'וּ'
+			201: 40,  // '…'
+			202: 34,  // '\xa0'
+			203: 29,  // 'ָ'
+			204: 31,  // 'ַ'
+			205: 37,  // 'ֵ'
+			206: 36,  // 'ֶ'
+			207: 33,  // 'ִ'
+			208: 32,  // '–'
+			209: 94,  // '—'
+			210: 47,  // '“'
+			211: 46,  // '”'
+			212: 83,  // '‘'
+			213: 52,  // '’'
+			214: 250, // 'שׁ'
+			215: 250, // 'שׂ'
+			216: 237, // 'ֿ'
+			217: 30,  // 'ְ'
+			218: 41,  // 'ֲ'
+			219: 59,  // 'ֱ'
+			220: 62,  // 'ֻ'
+			221: 35,  // 'ֹ'
+			222: 29,  // 'ָ'
+			223: 88,  // 'ֳ'
+			224: 9,   // 'א'
+			225: 8,   // 'ב'
+			226: 20,  // 'ג'
+			227: 16,  // 'ד'
+			228: 3,   // 'ה'
+			229: 2,   // 'ו'
+			230: 24,  // 'ז'
+			231: 14,  // 'ח'
+			232: 22,  // 'ט'
+			233: 1,   // 'י'
+			234: 25,  // 'ך'
+			235: 15,  // 'כ'
+			236: 4,   // 'ל'
+			237: 11,  // 'ם'
+			238: 6,   // 'מ'
+			239: 23,  // 'ן'
+			240: 12,  // 'נ'
+			241: 19,  // 'ס'
+			242: 13,  // 'ע'
+			243: 26,  // 'ף'
+			244: 18,  // 'פ'
+			245: 27,  // 'ץ'
+			246: 21,  // 'צ'
+			247: 17,  // 'ק'
+			248: 7,   // 'ר'
+			249: 10,  // 'ש'
+			250: 5,   // 'ת'
+			251: 250, // '}'
+			252: 250, // ']'
+			253: 250, // '{'
+			254: 250, // '['
+			255: 250, // '|'
+		},
+		LanguageModel:        NewWindows1255HebrewModel().LanguageModel,
+		TypicalPositiveRatio: 0.984004,
+		KeepAsciiLetters:     false,
+		Alphabet:             "אבגדהוזחטיךכלםמןנסעףפץצקרשתװױײ",
+	}
+}
@@ -104,12 +104,13 @@ func (l *Latin1Probe) Language() string {
 }
 
 func (l *Latin1Probe) Feed(buf []byte) consts.ProbingState {
+	fed := len(buf)
 	buf = l.FilterWithEnglishLetters(buf)
 	for _, b := range buf {
 		charCls := l.Char2Class[int(b)]
 		freq := l.ClassModel[(l.lastCharClass*Latin1ClassNum)+charCls]
 		if freq == 0 {
-			l.state = consts.NotMeProbingState
+			l.decide(consts.NotMeProbingState, fed, "byte 0x%02X cannot follow the letter before it", b)
 			break
 		}
 		l.freqCounter[freq]++
@@ -0,0 +1,58 @@
+package probe
+
+import (
+	"log/slog"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+// logger is implemented by the probes that log the verdicts of the probes and
+// escape sequences they hold.
+type logger interface {
+	setLogger(l *slog.Logger)
+}
+
+// SetLogger makes p, and the groups among the probes it holds, log at debug
+// level the verdicts reached inside them. A nil l stops logging.
+func SetLogger(p Probe, l *slog.Logger) {
+	if lg, ok := p.(logger); ok {
+		lg.setLogger(l)
+	}
+}
+
+func (c *CharSetGroupProbe) setLogger(l *slog.Logger) {
+	c.logger = l
+	for _, p := range c.probes {
+		if p != nil {
+			SetLogger(p, l)
+		}
+	}
+}
+
+func (e *EscCharSetProbe) setLogger(l *slog.Logger) {
+	e.logger = l
+}
+
+// LogVerdict logs at debug level the verdict p reached, if any.
+func LogVerdict(l *slog.Logger, p Probe) {
+	if l == nil {
+		return
+	}
+
+	var verdict Verdict
+	if v, ok := p.(interface{ Verdict() Verdict }); ok {
+		verdict = v.Verdict()
+	}
+	msg := "probe ruled out"
+	if s, ok := p.(interface{ State() consts.ProbingState }); ok && s.State() == consts.FoundItProbingState {
+		msg = "probe found it"
+	}
+
+	l.Debug(msg,
+		"probe", typeName(p),
+		"charset", p.CharSetName(),
+		"language", p.Language(),
+		"confidence", p.GetConfidence(),
+		"offset", verdict.Offset,
+		"reason", verdict.Reason)
+}
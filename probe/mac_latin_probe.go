@@ -0,0 +1,207 @@
+package probe
+
+import (
+	"github.com/wlynxg/chardet/consts"
+)
+
+// MacLatinProbe detects the Latin-script relatives of MacRoman. It shares the
+// class model and the rarity prior of MacRomanProbe and only differs in how
+// bytes are mapped to character classes. Since the class model scores most
+// Latin text alike, the probe only reports a confidence once it has seen a
+// letter that MacRoman would read as a symbol.
+type MacLatinProbe struct {
+	*MacRomanProbe
+
+	charsetName string
+	distinctive [256]bool
+	seen        int
+}
+
+func newMacLatinProbe(charsetName string, char2Class []int) *MacLatinProbe {
+	p := &MacLatinProbe{
+		MacRomanProbe: NewMacRomanProbe(),
+		charsetName:   charsetName,
+	}
+	for b, class := range char2Class {
+		p.distinctive[b] = isMacLetterClass(class) && !isMacLetterClass(p.Char2Class[b])
+	}
+	p.Char2Class = char2Class
+	return p
+}
+
+func isMacLetterClass(class int) bool {
+	return class == ACV || class == ACO || class == ASV || class == ASO
+}
+
+// NewLatinProbes returns the probes of the Latin-script charsets for the
+// languages in filter, UnknownLangFilter standing for every language. The Mac
+// Latin relatives share MacRoman's class model and often tie with it, so the
+// earlier probe wins: the ones that change the fewest MacRoman letters come
+// first.
+func NewLatinProbes(filter consts.LangFilter) []Probe {
+	if filter == consts.UnknownLangFilter {
+		filter = consts.AllLangFilter
+	}
+	filter = filter.Expand()
+
+	var probes []Probe
+	if filter&consts.WesternEuropeanLangFilter != 0 {
+		probes = append(probes, NewLatin1Probe())
+	}
+	if filter&consts.TurkishLangFilter != 0 {
+		probes = append(probes, NewMacTurkishProbe())
+	}
+	if filter&consts.WesternEuropeanLangFilter != 0 {
+		probes = append(probes, NewMacIcelandicProbe())
+	}
+	if filter&consts.CentralEuropeanLangFilter != 0 {
+		probes = append(probes, NewMacCentralEuropeProbe())
+	}
+	if filter&consts.WesternEuropeanLangFilter != 0 {
+		probes = append(probes, NewMacRomanProbe())
+	}
+	return probes
+}
+
+// NewMacCentralEuropeProbe returns a probe for Mac OS Central European, used
+// for Czech, Slovak, Polish, Hungarian and the Baltic languages.
+func NewMacCentralEuropeProbe() *MacCharSetProbe {
+	return NewMacCharSetProbe(newMacLatinProbe(consts.MacCentralEurope, []int{
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 00 - 07
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 08 - 0F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 10 - 17
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 18 - 1F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 20 - 27
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 28 - 2F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 30 - 37
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 38 - 3F
+		OTH, ASC, ASC, ASC, ASC, ASC, ASC, ASC, // 40 - 47
+		ASC, ASC, ASC, ASC, ASC, ASC, ASC, ASC, // 48 - 4F
+		ASC, ASC, ASC, ASC, ASC, ASC, ASC, ASC, // 50 - 57
+		ASC, ASC, ASC, OTH, OTH, OTH, OTH, OTH, // 58 - 5F
+		OTH, ASS, ASS, ASS, ASS, ASS, ASS, ASS, // 60 - 67
+		ASS, ASS, ASS, ASS, ASS, ASS, ASS, ASS, // 68 - 6F
+		ASS, ASS, ASS, ASS, ASS, ASS, ASS, ASS, // 70 - 77
+		ASS, ASS, ASS, OTH, OTH, OTH, OTH, OTH, // 78 - 7F
+		ACV, ACV, ASV, ACV, ACV, ACV, ACV, ASV, // 80 - 87
+		ASV, ACO, ASV, ASO, ACO, ASO, ASV, ACO, // 88 - 8F
+		ASO, ACO, ASV, ASO, ACV, ASV, ACV, ASV, // 90 - 97
+		ASV, ASV, ASV, ASV, ASV, ACV, ASV, ASV, // 98 - 9F
+		OTH, OTH, ACV, OTH, OTH, OTH, OTH, ASO, // A0 - A7
+		OTH, OTH, ODD, ASV, OTH, OTH, ASO, ACV, // A8 - AF
+		ASV, ACV, OTH, OTH, ASV, ACO, OTH, OTH, // B0 - B7
+		ASO, ACO, ASO, ACO, ASO, ACO, ASO, ACO, // B8 - BF
+		ASO, ACO, ODD, OTH, ASO, ACO, OTH, OTH, // C0 - C7
+		OTH, OTH, OTH, ASO, ACV, ACV, ASV, ACV, // C8 - CF
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, ODD, // D0 - D7
+		ASV, ACO, ASO, ACO, OTH, OTH, ASO, ACO, // D8 - DF
+		ASO, ACO, OTH, OTH, ASO, ACO, ASO, ACV, // E0 - E7
+		ACO, ASO, ACV, ACO, ASO, ACV, ACV, ACV, // E8 - EF
+		ASV, ACV, ACV, ASV, ACV, ASV, ACV, ASV, // F0 - F7
+		ACV, ASV, ASO, ACO, ACO, ASO, ACO, ODD, // F8 - FF
+	}), macCentralEuropeOnlyBytes)
+}
+
+// NewMacIcelandicProbe returns a probe for Mac OS Icelandic, which replaces a
+// few MacRoman symbols with Ð, ð, Þ, þ, Ý and ý.
+func NewMacIcelandicProbe() *MacCharSetProbe {
+	return NewMacCharSetProbe(newMacLatinProbe(consts.MacIcelandic, []int{
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 00 - 07
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 08 - 0F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 10 - 17
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 18 - 1F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 20 - 27
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 28 - 2F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 30 - 37
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 38 - 3F
+		OTH, ASC, ASC, ASC, ASC, ASC, ASC, ASC, // 40 - 47
+		ASC, ASC, ASC, ASC, ASC, ASC, ASC, ASC, // 48 - 4F
+		ASC, ASC, ASC, ASC, ASC, ASC, ASC, ASC, // 50 - 57
+		ASC, ASC, ASC, OTH, OTH, OTH, OTH, OTH, // 58 - 5F
+		OTH, ASS, ASS, ASS, ASS, ASS, ASS, ASS, // 60 - 67
+		ASS, ASS, ASS, ASS, ASS, ASS, ASS, ASS, // 68 - 6F
+		ASS, ASS, ASS, ASS, ASS, ASS, ASS, ASS, // 70 - 77
+		ASS, ASS, ASS, OTH, OTH, OTH, OTH, OTH, // 78 - 7F
+		ACV, ACV, ACO, ACV, ACO, ACV, ACV, ASV, // 80 - 87
+		ASV, ASV, ASV, ASV, ASV, ASO, ASV, ASV, // 88 - 8F
+		ASV, ASV, ASV, ASV, ASV, ASV, ASO, ASV, // 90 - 97
+		ASV, ASV, ASV, ASV, ASV, ASV, ASV, ASV, // 98 - 9F
+		ACV, OTH, OTH, OTH, OTH, OTH, OTH, ASO, // A0 - A7
+		OTH, OTH, ODD, ODD, OTH, OTH, ACV, ACV, // A8 - AF
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // B0 - B7
+		OTH, OTH, OTH, OTH, OTH, OTH, ASV, ASV, // B8 - BF
+		OTH, OTH, ODD, OTH, ODD, OTH, OTH, OTH, // C0 - C7
+		OTH, OTH, OTH, ACV, ACV, ACV, ACV, ASV, // C8 - CF
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, ODD, // D0 - D7
+		ASV, ACV, ODD, OTH, ACO, ASO, ACO, ASO, // D8 - DF
+		ASV, OTH, OTH, OTH, OTH, ACV, ACV, ACV, // E0 - E7
+		ACV, ACV, ACV, ACV, ACV, ACV, ACV, ACV, // E8 - EF
+		ODD, ACV, ACV, ACV, ACV, ASV, ODD, ODD, // F0 - F7
+		ODD, ODD, ODD, ODD, ODD, ODD, ODD, ODD, // F8 - FF
+	}), macIcelandicOnlyBytes)
+}
+
+// NewMacTurkishProbe returns a probe for Mac OS Turkish, which replaces a few
+// MacRoman symbols with Ğ, ğ, İ, ı, Ş and ş.
+func NewMacTurkishProbe() *MacCharSetProbe {
+	return NewMacCharSetProbe(newMacLatinProbe(consts.MacTurkish, []int{
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 00 - 07
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 08 - 0F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 10 - 17
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 18 - 1F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 20 - 27
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 28 - 2F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 30 - 37
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // 38 - 3F
+		OTH, ASC, ASC, ASC, ASC, ASC, ASC, ASC, // 40 - 47
+		ASC, ASC, ASC, ASC, ASC, ASC, ASC, ASC, // 48 - 4F
+		ASC, ASC, ASC, ASC, ASC, ASC, ASC, ASC, // 50 - 57
+		ASC, ASC, ASC, OTH, OTH, OTH, OTH, OTH, // 58 - 5F
+		OTH, ASS, ASS, ASS, ASS, ASS, ASS, ASS, // 60 - 67
+		ASS, ASS, ASS, ASS, ASS, ASS, ASS, ASS, // 68 - 6F
+		ASS, ASS, ASS, ASS, ASS, ASS, ASS, ASS, // 70 - 77
+		ASS, ASS, ASS, OTH, OTH, OTH, OTH, OTH, // 78 - 7F
+		ACV, ACV, ACO, ACV, ACO, ACV, ACV, ASV, // 80 - 87
+		ASV, ASV, ASV, ASV, ASV, ASO, ASV, ASV, // 88 - 8F
+		ASV, ASV, ASV, ASV, ASV, ASV, ASO, ASV, // 90 - 97
+		ASV, ASV, ASV, ASV, ASV, ASV, ASV, ASV, // 98 - 9F
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, ASO, // A0 - A7
+		OTH, OTH, ODD, ODD, OTH, OTH, ACV, ACV, // A8 - AF
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, OTH, // B0 - B7
+		OTH, OTH, OTH, OTH, OTH, OTH, ASV, ASV, // B8 - BF
+		OTH, OTH, ODD, OTH, ODD, OTH, OTH, OTH, // C0 - C7
+		OTH, OTH, OTH, ACV, ACV, ACV, ACV, ASV, // C8 - CF
+		OTH, OTH, OTH, OTH, OTH, OTH, OTH, ODD, // D0 - D7
+		ASV, ACV, ACO, ASO, ACV, ASV, ACO, ASO, // D8 - DF
+		OTH, OTH, OTH, OTH, OTH, ACV, ACV, ACV, // E0 - E7
+		ACV, ACV, ACV, ACV, ACV, ACV, ACV, ACV, // E8 - EF
+		ODD, ACV, ACV, ACV, ACV, OTH, ODD, ODD, // F0 - F7
+		ODD, ODD, ODD, ODD, ODD, ODD, ODD, ODD, // F8 - FF
+
+	}), macTurkishOnlyBytes)
+}
+
+func (m *MacLatinProbe) Reset() {
+	m.MacRomanProbe.Reset()
+	m.seen = 0
+}
+
+func (m *MacLatinProbe) CharSetName() string {
+	return m.charsetName
+}
+
+func (m *MacLatinProbe) Feed(buf []byte) consts.ProbingState {
+	for _, b := range buf {
+		if m.distinctive[b] {
+			m.seen++
+		}
+	}
+	return m.MacRomanProbe.Feed(buf)
+}
+
+func (m *MacLatinProbe) GetConfidence() float64 {
+	if m.seen == 0 {
+		return 0.01
+	}
+	return m.MacRomanProbe.GetConfidence()
+}
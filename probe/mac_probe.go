@@ -0,0 +1,113 @@
+package probe
+
+import (
+	"github.com/wlynxg/chardet/consts"
+)
+
+// Bytes that decode to letters or digits in a Mac code page but to other
+// characters, or nothing at all, in the ISO-8859 and Windows code pages of the
+// same script.
+var (
+	macCentralEuropeOnlyBytes = []byte{
+		0x80, 0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x8A, 0x8B, 0x8C, 0x8D, 0x8E, 0x90, 0x91, 0x92, 0x93, 0x94, 0x95, 0x96, 0x97, 0x98, 0x99, 0x9A, 0x9B, 0x9C, 0x9D, 0x9E, 0x9F, 0xA2, 0xA7, 0xAB, 0xAE, 0xAF, 0xB0, 0xB1, 0xB4, 0xB5, 0xB8, 0xB9, 0xBA, 0xBB, 0xBC, 0xBD, 0xBE, 0xBF, 0xC0, 0xC1, 0xC4, 0xC5, 0xCB, 0xCC, 0xCD, 0xCE, 0xCF, 0xD8, 0xD9, 0xDA, 0xDB, 0xDE, 0xDF, 0xE0, 0xE1, 0xE4, 0xE5, 0xE6, 0xE7, 0xE8, 0xE9, 0xEA, 0xEB, 0xEC, 0xED, 0xEE, 0xEF, 0xF0, 0xF1, 0xF2, 0xF3, 0xF4, 0xF5, 0xF6, 0xF7, 0xF8, 0xF9, 0xFA, 0xFB, 0xFC, 0xFD, 0xFE, 0xFF,
+	}
+	macIcelandicOnlyBytes = []byte{
+		0x80, 0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x8A, 0x8B, 0x8C, 0x8D, 0x8E, 0x8F, 0x90, 0x91, 0x92, 0x93, 0x94, 0x95, 0x96, 0x97, 0x98, 0x99, 0x9A, 0x9B, 0x9C, 0x9D, 0x9E, 0x9F, 0xA0, 0xA7, 0xAE, 0xAF, 0xB9, 0xBB, 0xBC, 0xBD, 0xBE, 0xBF, 0xC4, 0xCB, 0xCC, 0xCD, 0xCE, 0xCF, 0xD8, 0xD9, 0xDC, 0xDD, 0xDF, 0xE0, 0xE5, 0xE6, 0xE7, 0xE8, 0xE9, 0xEA, 0xEB, 0xEC, 0xED, 0xEE, 0xEF, 0xF1, 0xF2, 0xF3, 0xF4, 0xF5, 0xF6, 0xFF,
+	}
+	macGreekOnlyBytes = []byte{
+		0x80, 0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x88, 0x89, 0x8A, 0x8D, 0x8E, 0x8F, 0x90, 0x91, 0x94, 0x95, 0x97, 0x99, 0x9A, 0x9D, 0x9E, 0x9F, 0xA1, 0xA2, 0xA3, 0xA4, 0xA5, 0xA6, 0xA7, 0xAA, 0xAB, 0xB0, 0xB5, 0xB6, 0xB7, 0xB8, 0xB9, 0xBA, 0xBB, 0xBC, 0xBD, 0xBE, 0xBF, 0xC0, 0xC1, 0xC3, 0xC4, 0xC6, 0xCB, 0xCC, 0xCD, 0xCE, 0xCF, 0xD7, 0xD8, 0xD9, 0xDA, 0xDB, 0xDC, 0xDD, 0xDE, 0xDF, 0xE0, 0xE3, 0xE6, 0xE7, 0xE8, 0xEA, 0xEB, 0xEC, 0xED, 0xEE, 0xF1, 0xF2, 0xF5, 0xF6, 0xF7, 0xF8, 0xF9, 0xFA, 0xFB, 0xFC, 0xFD, 0xFE,
+	}
+	macTurkishOnlyBytes = []byte{
+		0x80, 0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x8A, 0x8B, 0x8C, 0x8D, 0x8E, 0x8F, 0x90, 0x91, 0x92, 0x93, 0x94, 0x95, 0x96, 0x97, 0x98, 0x99, 0x9A, 0x9B, 0x9C, 0x9D, 0x9E, 0x9F, 0xA7, 0xAE, 0xAF, 0xB9, 0xBB, 0xBC, 0xBD, 0xBE, 0xBF, 0xC4, 0xCB, 0xCC, 0xCD, 0xCE, 0xCF, 0xD8, 0xD9, 0xDA, 0xDB, 0xDC, 0xDD, 0xDF, 0xE5, 0xE6, 0xE7, 0xE8, 0xE9, 0xEA, 0xEB, 0xEC, 0xED, 0xEE, 0xEF, 0xF1, 0xF2, 0xF3, 0xF4, 0xF6, 0xFF,
+	}
+	// only the bytes Windows-1255 leaves undefined: it uses the others for
+	// punctuation and niqqud
+	macHebrewOnlyBytes = []byte{
+		0x81, 0x8A, 0x8C, 0x8D, 0x8E, 0x8F, 0x90, 0x9A, 0x9C, 0x9D, 0x9E, 0x9F, 0xCA, 0xD9, 0xDA, 0xDB, 0xDC, 0xDD, 0xDE, 0xDF, 0xFB, 0xFC, 0xFF,
+	}
+	macArabicOnlyBytes = []byte{
+		0x80, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x8A, 0x8B, 0x8D, 0x8E, 0x8F, 0x90, 0x91, 0x92, 0x94, 0x95, 0x96, 0x97, 0x99, 0x9A, 0x9C, 0x9D, 0x9E, 0x9F, 0xB0, 0xB1, 0xB2, 0xB3, 0xB4, 0xB5, 0xB6, 0xB7, 0xB8, 0xB9, 0xF3, 0xF4, 0xF5, 0xF6, 0xF7, 0xF8, 0xF9, 0xFA, 0xFE,
+	}
+)
+
+// MacCharSetProbe wraps the probe of a classic Mac OS code page. Mac code
+// pages are rare nowadays and often share most of their letters with the
+// ISO-8859 or Windows code page of the same script, so ordinary PC text can
+// look like perfectly good Mac text. The probe therefore stays silent until
+// it has seen enough bytes that only the Mac code page reads as letters.
+type MacCharSetProbe struct {
+	Probe
+
+	// MinMacOnlyChars is the number of Mac-only bytes needed before the
+	// wrapped probe's confidence is reported.
+	MinMacOnlyChars int
+
+	macOnly      [256]bool
+	macOnlyChars int
+}
+
+func NewMacCharSetProbe(p Probe, macOnlyBytes []byte) *MacCharSetProbe {
+	m := &MacCharSetProbe{
+		Probe:           p,
+		MinMacOnlyChars: 3,
+	}
+	for _, b := range macOnlyBytes {
+		m.macOnly[b] = true
+	}
+	return m
+}
+
+// NewMacGreekProbe returns a probe for Mac OS Greek.
+func NewMacGreekProbe() *MacCharSetProbe {
+	return NewMacCharSetProbe(NewSingleByteCharSetProbe(NewMacGreekGreekModel(), false, nil), macGreekOnlyBytes)
+}
+
+// NewMacHebrewProbe returns a probe for Mac OS Hebrew.
+func NewMacHebrewProbe() *MacCharSetProbe {
+	return NewMacCharSetProbe(NewSingleByteCharSetProbe(NewMacHebrewHebrewModel(), false, nil), macHebrewOnlyBytes)
+}
+
+// NewMacArabicProbe returns a probe for Mac OS Arabic.
+func NewMacArabicProbe() *MacCharSetProbe {
+	return NewMacCharSetProbe(NewSingleByteCharSetProbe(NewMacArabicArabicModel(), false, nil), macArabicOnlyBytes)
+}
+
+func (m *MacCharSetProbe) Reset() {
+	m.Probe.Reset()
+	m.macOnlyChars = 0
+}
+
+func (m *MacCharSetProbe) Feed(buf []byte) consts.ProbingState {
+	for _, b := range buf {
+		if m.macOnly[b] {
+			m.macOnlyChars++
+		}
+	}
+
+	state := m.Probe.Feed(buf)
+	if state == consts.FoundItProbingState && m.macOnlyChars < m.MinMacOnlyChars {
+		// the wrapped probe cannot tell the Mac code page from the PC one yet
+		return consts.DetectingProbingState
+	}
+	return state
+}
+
+// State returns the state of the wrapped probe, which is still detecting until
+// enough Mac-only bytes were seen to trust its answer.
+func (m *MacCharSetProbe) State() consts.ProbingState {
+	s, ok := m.Probe.(interface{ State() consts.ProbingState })
+	if !ok {
+		return consts.DetectingProbingState
+	}
+	if state := s.State(); state != consts.FoundItProbingState || m.macOnlyChars >= m.MinMacOnlyChars {
+		return state
+	}
+	return consts.DetectingProbingState
+}
+
+func (m *MacCharSetProbe) GetConfidence() float64 {
+	if m.macOnlyChars < m.MinMacOnlyChars {
+		return 0.01
+	}
+	return m.Probe.GetConfidence()
+}
@@ -92,12 +92,13 @@ func (m *MacRomanProbe) Language() string {
 }
 
 func (m *MacRomanProbe) Feed(buf []byte) consts.ProbingState {
+	fed := len(buf)
 	buf = m.RemoveXMLTags(buf)
 	for _, b := range buf {
 		charClass := m.Char2Class[int(b)]
 		freq := m.ClassModel[(m.lastCharClass*MacRomanClassNum)+charClass]
 		if freq == 0 {
-			m.state = consts.NotMeProbingState
+			m.decide(consts.NotMeProbingState, fed, "byte 0x%02X cannot follow the letter before it", b)
 			break
 		}
 		m.freqCounter[freq]++
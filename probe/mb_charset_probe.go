@@ -47,10 +47,10 @@ loop:
 		codingState := m.codingSM.NextState(buf[i])
 		switch codingState {
 		case consts.ErrorMachineState:
-			m.state = consts.NotMeProbingState
+			m.decide(consts.NotMeProbingState, i, "illegal byte 0x%02X", buf[i])
 			break loop
 		case consts.ItsMeMachineState:
-			m.state = consts.FoundItProbingState
+			m.decide(consts.FoundItProbingState, i, "byte 0x%02X ends a sequence only %s has", buf[i], m.CharSetName())
 			break loop
 		case consts.StartMachineState:
 			charLen := m.codingSM.CurrentCharLength()
@@ -68,7 +68,7 @@ loop:
 
 	if m.state == consts.DetectingProbingState {
 		if m.distributionAnalyzer.GotEnoughData() && m.GetConfidence() > m.ShortcutThreshold {
-			m.state = consts.FoundItProbingState
+			m.decide(consts.FoundItProbingState, len(buf), "confidence %.2f above shortcut threshold %.2f", m.GetConfidence(), m.ShortcutThreshold)
 		}
 	}
 	return m.state
@@ -0,0 +1,104 @@
+package probe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+// StateChange is a probe, or an escape sequence of EscCharSetProbe, finding
+// its charset or being ruled out.
+type StateChange struct {
+	// Probe is the type of the probe, such as "SingleByteCharSetProbe", or
+	// "state machine" for an escape sequence
+	Probe string
+	// Group is the type of the probe holding this one, if any
+	Group    string
+	Charset  string
+	Language string
+	// State is consts.FoundItProbingState or consts.NotMeProbingState
+	State   consts.ProbingState
+	Verdict Verdict
+}
+
+// Observer is told when the probes held by a group, or the escape sequences
+// of EscCharSetProbe, find their charset or are ruled out.
+type Observer interface {
+	OnProbeStateChange(change StateChange)
+}
+
+// observed is implemented by the probes that tell an Observer about the
+// probes and escape sequences they hold.
+type observed interface {
+	setObserver(o Observer, name string)
+}
+
+// SetObserver makes p, and the groups among the probes it holds, tell o when
+// the probes or escape sequences inside them find their charset or are ruled
+// out. A nil o stops the calls.
+func SetObserver(p Probe, o Observer) {
+	if ob, ok := p.(observed); ok {
+		ob.setObserver(o, typeName(p))
+	}
+}
+
+func (c *CharSetGroupProbe) setObserver(o Observer, name string) {
+	c.observer, c.name = o, name
+	for _, p := range c.probes {
+		if p != nil {
+			SetObserver(p, o)
+		}
+	}
+}
+
+func (e *EscCharSetProbe) setObserver(o Observer, _ string) {
+	e.observer = o
+}
+
+// StateChangeOf returns the change p went through, a member of group if not
+// empty, to reach its current state.
+func StateChangeOf(p Probe, group string) StateChange {
+	change := StateChange{
+		Probe:    typeName(p),
+		Group:    group,
+		Charset:  p.CharSetName(),
+		Language: p.Language(),
+		State:    consts.NotMeProbingState,
+	}
+	if s, ok := p.(interface{ State() consts.ProbingState }); ok {
+		change.State = s.State()
+	}
+	if v, ok := p.(interface{ Verdict() Verdict }); ok {
+		change.Verdict = v.Verdict()
+	}
+	return change
+}
+
+// notify tells the observer of the group that member p reached a verdict.
+func (c *CharSetGroupProbe) notify(p Probe) {
+	if c.observer != nil {
+		c.observer.OnProbeStateChange(StateChangeOf(p, c.name))
+	}
+}
+
+// notify tells the observer of the probe that the escape sequence of machine
+// reached a verdict.
+func (e *EscCharSetProbe) notify(machine *CodingStateMachine, state consts.ProbingState, verdict Verdict) {
+	if e.observer != nil {
+		e.observer.OnProbeStateChange(StateChange{
+			Probe:    "state machine",
+			Group:    typeName(e),
+			Charset:  machine.CodingStateMachine(),
+			Language: machine.Language(),
+			State:    state,
+			Verdict:  verdict,
+		})
+	}
+}
+
+// typeName names the type of p, without its package.
+func typeName(p Probe) string {
+	name := fmt.Sprintf("%T", p)
+	return name[strings.LastIndex(name, ".")+1:]
+}
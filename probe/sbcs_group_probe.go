@@ -8,7 +8,16 @@ type SBCSGroupProbe struct {
 	CharSetGroupProbe
 }
 
+// NewSBCSGroupProbe returns the group of single-byte probes for every
+// language.
 func NewSBCSGroupProbe() *SBCSGroupProbe {
+	return NewSBCSGroupProbeWithFilter(consts.UnknownLangFilter)
+}
+
+// NewSBCSGroupProbeWithFilter returns the group of single-byte probes for the
+// languages in filter. UnknownLangFilter stands for every language. ISCII is
+// probed as soon as one of the languages it is written in is selected.
+func NewSBCSGroupProbeWithFilter(filter consts.LangFilter) *SBCSGroupProbe {
 	p := &SBCSGroupProbe{}
 	p.CharSetGroupProbe = NewCharSetGroupProbe(consts.UnknownLangFilter, nil)
 	hp := NewHebrewProbe()
@@ -27,14 +36,41 @@ func NewSBCSGroupProbe() *SBCSGroupProbe {
 
 		NewSingleByteCharSetProbe(NewISO88597GreekModel(), false, nil),
 		NewSingleByteCharSetProbe(NewWindows1253GreekModel(), false, nil),
+		NewMacGreekProbe(),
 
 		NewSingleByteCharSetProbe(NewISO88595BulgarianModel(), false, nil),
 		NewSingleByteCharSetProbe(NewWindows1251BulgarianModel(), false, nil),
 
 		NewSingleByteCharSetProbe(NewTis620ThaiModel(), false, nil),
 		NewSingleByteCharSetProbe(NewIso88599TurkishModel(), false, nil),
+
+		NewSingleByteCharSetProbe(NewArmscii8ArmenianModel(), false, nil),
+		NewSingleByteCharSetProbe(NewGeorgianPSGeorgianModel(), false, nil),
+		NewSingleByteCharSetProbe(NewGeorgianAcademyGeorgianModel(), false, nil),
+
 		logical,
 		visual,
+		NewMacHebrewProbe(),
+
+		NewMacArabicProbe(),
+
+		NewIsciiProbe(),
+		NewTsciiProbe(),
+	}
+
+	if filter != consts.UnknownLangFilter {
+		filter = filter.Expand()
+		probes := p.probes[:0]
+		for _, probe := range p.probes {
+			languages := consts.LanguageFilter(probe.Language())
+			if _, ok := probe.(*IsciiProbe); ok {
+				languages = consts.IndicLangFilter
+			}
+			if filter&languages != 0 {
+				probes = append(probes, probe)
+			}
+		}
+		p.probes = probes
 	}
 	p.Reset()
 	return p
@@ -83,6 +83,7 @@ func (s *SingleByteCharSetProbe) Language() string {
 }
 
 func (s *SingleByteCharSetProbe) Feed(buf []byte) consts.ProbingState {
+	fed := len(buf)
 	if !s.model.KeepAsciiLetters {
 		buf = s.FilterInternationalWords(buf)
 	} else {
@@ -123,9 +124,11 @@ func (s *SingleByteCharSetProbe) Feed(buf []byte) consts.ProbingState {
 		if s.totalSeqs > s.SBEnoughRelThreshold {
 			confidence := s.GetConfidence()
 			if confidence > s.PositiveShortcutThreshold {
-				s.state = consts.FoundItProbingState
+				s.decide(consts.FoundItProbingState, fed, "confidence %.2f above positive shortcut threshold %.2f",
+					confidence, s.PositiveShortcutThreshold)
 			} else if confidence < s.NegativeShortcutThreshold {
-				s.state = consts.NotMeProbingState
+				s.decide(consts.NotMeProbingState, fed, "confidence %.2f below negative shortcut threshold %.2f",
+					confidence, s.NegativeShortcutThreshold)
 			}
 		}
 	}
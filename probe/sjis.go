@@ -42,10 +42,10 @@ loop:
 		codingState := s.codingSM.NextState(buf[i])
 		switch codingState {
 		case consts.ErrorMachineState:
-			s.state = consts.NotMeProbingState
+			s.decide(consts.NotMeProbingState, i, "illegal byte 0x%02X", buf[i])
 			break loop
 		case consts.ItsMeMachineState:
-			s.state = consts.FoundItProbingState
+			s.decide(consts.FoundItProbingState, i, "byte 0x%02X ends a sequence only %s has", buf[i], s.CharSetName())
 			break loop
 		case consts.StartMachineState:
 			charLen := s.codingSM.CurrentCharLength()
@@ -72,7 +72,7 @@ loop:
 	if s.state == consts.DetectingProbingState &&
 		s.contextAnalyzer.GotEnoughData() &&
 		(s.GetConfidence() > s.ShortcutThreshold) {
-		s.state = consts.FoundItProbingState
+		s.decide(consts.FoundItProbingState, len(buf), "confidence %.2f above shortcut threshold %.2f", s.GetConfidence(), s.ShortcutThreshold)
 	}
 	return s.state
 }
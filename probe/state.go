@@ -0,0 +1,507 @@
+package probe
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/internal/state"
+)
+
+// stateful is implemented by the probes whose state can be saved and restored.
+type stateful interface {
+	encodeState(e *state.Encoder)
+	decodeState(d *state.Decoder)
+}
+
+// MarshalState encodes what p has learned from the data fed so far. The
+// settings p was built with are not part of it: UnmarshalState restores the
+// state into a probe built the same way.
+func MarshalState(p Probe) ([]byte, error) {
+	var e state.Encoder
+	encodeProbe(&e, p)
+	return e.Bytes(), e.Err()
+}
+
+// UnmarshalState restores into p the state encoded by MarshalState.
+func UnmarshalState(p Probe, data []byte) error {
+	d := state.NewDecoder(data)
+	decodeProbe(d, p)
+	return d.Err()
+}
+
+func encodeProbe(e *state.Encoder, p Probe) {
+	s, ok := p.(stateful)
+	if !ok {
+		e.Fail(fmt.Errorf("probe: cannot save the state of %T", p))
+		return
+	}
+	s.encodeState(e)
+}
+
+func decodeProbe(d *state.Decoder, p Probe) {
+	s, ok := p.(stateful)
+	if !ok {
+		d.Fail(fmt.Errorf("probe: cannot restore the state of %T", p))
+		return
+	}
+	s.decodeState(d)
+}
+
+// decodeInts reads a slice that must be as long as the one it replaces.
+func decodeInts(d *state.Decoder, v []int) {
+	n := d.Ints()
+	if len(n) != len(v) {
+		d.Fail(state.ErrCorrupt)
+		return
+	}
+	copy(v, n)
+}
+
+func (v *Verdict) encodeState(e *state.Encoder) {
+	e.Int(v.Offset)
+	e.String(v.Reason)
+}
+
+func (v *Verdict) decodeState(d *state.Decoder) {
+	v.Offset = d.Int()
+	v.Reason = d.String()
+}
+
+func (c *CharSetProbe) encodeState(e *state.Encoder) {
+	e.Bool(c.active)
+	e.Byte(byte(c.state))
+	e.Int(c.offset)
+	c.verdict.encodeState(e)
+}
+
+func (c *CharSetProbe) decodeState(d *state.Decoder) {
+	c.active = d.Bool()
+	if c.state = consts.ProbingState(d.Byte()); c.state > consts.NotMeProbingState {
+		d.Fail(state.ErrCorrupt)
+	}
+	c.offset = d.Int()
+	c.verdict.decodeState(d)
+}
+
+func (c *CharSetGroupProbe) encodeState(e *state.Encoder) {
+	c.CharSetProbe.encodeState(e)
+	e.Int(c.activeNum)
+	best := -1
+	for i, p := range c.probes {
+		if p == c.bestGuessProbe {
+			best = i
+		}
+	}
+	e.Int(len(c.probes))
+	e.Int(best)
+	for _, p := range c.probes {
+		encodeProbe(e, p)
+	}
+}
+
+func (c *CharSetGroupProbe) decodeState(d *state.Decoder) {
+	c.CharSetProbe.decodeState(d)
+	c.activeNum = d.Range(0, len(c.probes)+1)
+	if d.Int() != len(c.probes) {
+		d.Fail(state.ErrCorrupt)
+		return
+	}
+	best := d.Int()
+	switch {
+	case best == -1:
+		c.bestGuessProbe = nil
+	case best >= 0 && best < len(c.probes):
+		c.bestGuessProbe = c.probes[best]
+	default:
+		d.Fail(state.ErrCorrupt)
+	}
+	for _, p := range c.probes {
+		decodeProbe(d, p)
+	}
+}
+
+func (c *CodingStateMachine) encodeState(e *state.Encoder) {
+	e.Byte(byte(c.currState))
+	e.Int(c.currBytePos)
+	e.Int(c.currCharLen)
+	e.Bool(c.Active)
+}
+
+func (c *CodingStateMachine) decodeState(d *state.Decoder) {
+	if c.currState = consts.MachineState(d.Byte()); int(c.currState) >= len(c.model.StateTable)/int(c.model.ClassFactor) {
+		d.Fail(state.ErrCorrupt)
+	}
+	c.currBytePos = d.Range(0, math.MaxInt)
+	maxCharLen := 0
+	for _, l := range c.model.CharLenTable {
+		maxCharLen = max(maxCharLen, int(l))
+	}
+	c.currCharLen = d.Range(0, maxCharLen+1)
+	c.Active = d.Bool()
+}
+
+func (m *MultiByteCharSetProbe) encodeState(e *state.Encoder) {
+	m.CharSetProbe.encodeState(e)
+	m.codingSM.encodeState(e)
+	e.Marshal(m.distributionAnalyzer)
+	e.Byte(m.lastChar[0])
+	e.Byte(m.lastChar[1])
+}
+
+func (m *MultiByteCharSetProbe) decodeState(d *state.Decoder) {
+	m.CharSetProbe.decodeState(d)
+	m.codingSM.decodeState(d)
+	d.Unmarshal(m.distributionAnalyzer)
+	m.lastChar[0] = d.Byte()
+	m.lastChar[1] = d.Byte()
+}
+
+func (s *SJISProbe) encodeState(e *state.Encoder) {
+	s.MultiByteCharSetProbe.encodeState(e)
+	e.Byte(byte(s.state))
+	e.Marshal(s.contextAnalyzer)
+}
+
+func (s *SJISProbe) decodeState(d *state.Decoder) {
+	s.MultiByteCharSetProbe.decodeState(d)
+	if s.state = consts.ProbingState(d.Byte()); s.state > consts.NotMeProbingState {
+		d.Fail(state.ErrCorrupt)
+	}
+	d.Unmarshal(s.contextAnalyzer)
+}
+
+func (e *EUCJPProbe) encodeState(enc *state.Encoder) {
+	e.MultiByteCharSetProbe.encodeState(enc)
+	enc.Marshal(e.contextAnalyzer)
+}
+
+func (e *EUCJPProbe) decodeState(d *state.Decoder) {
+	e.MultiByteCharSetProbe.decodeState(d)
+	d.Unmarshal(e.contextAnalyzer)
+}
+
+func (u *UTF8Probe) encodeState(e *state.Encoder) {
+	u.CharSetProbe.encodeState(e)
+	u.codingSM.encodeState(e)
+	e.Int(u.numMbChars)
+}
+
+func (u *UTF8Probe) decodeState(d *state.Decoder) {
+	u.CharSetProbe.decodeState(d)
+	u.codingSM.decodeState(d)
+	u.numMbChars = d.Range(0, math.MaxInt)
+}
+
+func (s *SingleByteCharSetProbe) encodeState(e *state.Encoder) {
+	s.CharSetProbe.encodeState(e)
+	e.Int(s.lastOrder)
+	e.Ints(s.seqCounters)
+	e.Int(s.totalSeqs)
+	e.Int(s.totalChar)
+	e.Int(s.controlChar)
+	e.Int(s.freqChar)
+	// the Hebrew probe naming the logical and visual probes is in no group
+	e.Bool(s.nameProbe != nil)
+	if s.nameProbe != nil {
+		encodeProbe(e, s.nameProbe)
+	}
+}
+
+func (s *SingleByteCharSetProbe) decodeState(d *state.Decoder) {
+	s.CharSetProbe.decodeState(d)
+	s.lastOrder = d.Range(0, 256)
+	decodeInts(d, s.seqCounters)
+	s.totalSeqs = d.Int()
+	s.totalChar = d.Int()
+	s.controlChar = d.Int()
+	s.freqChar = d.Int()
+	if d.Bool() != (s.nameProbe != nil) {
+		d.Fail(state.ErrCorrupt)
+		return
+	}
+	if s.nameProbe != nil {
+		decodeProbe(d, s.nameProbe)
+	}
+}
+
+func (h *HebrewProbe) encodeState(e *state.Encoder) {
+	h.CharSetProbe.encodeState(e)
+	e.Int(h.finalCharLogicalScore)
+	e.Int(h.finalCharVisualScore)
+	e.Byte(h.prev)
+	e.Byte(h.beforePrev)
+}
+
+func (h *HebrewProbe) decodeState(d *state.Decoder) {
+	h.CharSetProbe.decodeState(d)
+	h.finalCharLogicalScore = d.Int()
+	h.finalCharVisualScore = d.Int()
+	h.prev = d.Byte()
+	h.beforePrev = d.Byte()
+}
+
+func (l *Latin1Probe) encodeState(e *state.Encoder) {
+	l.CharSetProbe.encodeState(e)
+	e.Int(l.lastCharClass)
+	e.Ints(l.freqCounter)
+}
+
+func (l *Latin1Probe) decodeState(d *state.Decoder) {
+	l.CharSetProbe.decodeState(d)
+	l.lastCharClass = d.Range(0, Latin1ClassNum)
+	decodeInts(d, l.freqCounter)
+}
+
+func (m *MacRomanProbe) encodeState(e *state.Encoder) {
+	m.CharSetProbe.encodeState(e)
+	e.Int(m.lastCharClass)
+	e.Ints(m.freqCounter)
+}
+
+func (m *MacRomanProbe) decodeState(d *state.Decoder) {
+	m.CharSetProbe.decodeState(d)
+	m.lastCharClass = d.Range(0, MacRomanClassNum)
+	decodeInts(d, m.freqCounter)
+}
+
+func (m *MacLatinProbe) encodeState(e *state.Encoder) {
+	m.MacRomanProbe.encodeState(e)
+	e.Int(m.seen)
+}
+
+func (m *MacLatinProbe) decodeState(d *state.Decoder) {
+	m.MacRomanProbe.decodeState(d)
+	m.seen = d.Int()
+}
+
+func (m *MacCharSetProbe) encodeState(e *state.Encoder) {
+	encodeProbe(e, m.Probe)
+	e.Int(m.macOnlyChars)
+}
+
+func (m *MacCharSetProbe) decodeState(d *state.Decoder) {
+	decodeProbe(d, m.Probe)
+	m.macOnlyChars = d.Int()
+}
+
+func (i *IsciiProbe) encodeState(e *state.Encoder) {
+	i.CharSetProbe.encodeState(e)
+	e.Int(i.lastClass)
+	e.Int(i.pending)
+	e.Byte(i.script)
+	e.Int(i.letters)
+	e.Int(i.marks)
+	e.Int(i.invalid)
+	scripts := make([]int, 0, len(i.scriptLetters))
+	for script := range i.scriptLetters {
+		scripts = append(scripts, int(script))
+	}
+	sort.Ints(scripts)
+	e.Int(len(scripts))
+	for _, script := range scripts {
+		e.Byte(byte(script))
+		e.Int(i.scriptLetters[byte(script)])
+	}
+}
+
+func (i *IsciiProbe) decodeState(d *state.Decoder) {
+	i.CharSetProbe.decodeState(d)
+	i.lastClass = d.Range(isciiOther, isciiIllegal+1)
+	i.pending = d.Range(isciiOther, isciiIllegal+1)
+	i.script = d.Byte()
+	i.letters = d.Int()
+	i.marks = d.Int()
+	i.invalid = d.Int()
+	n := d.Int()
+	if n < 0 || n > 256 {
+		d.Fail(state.ErrCorrupt)
+		return
+	}
+	i.scriptLetters = make(map[byte]int, n)
+	for j := 0; j < n; j++ {
+		script := d.Byte()
+		i.scriptLetters[script] = d.Int()
+	}
+}
+
+func (t *TsciiProbe) encodeState(e *state.Encoder) {
+	t.CharSetProbe.encodeState(e)
+	e.Int(t.lastClass)
+	e.Int(t.letters)
+	e.Int(t.marks)
+	e.Int(t.invalid)
+}
+
+func (t *TsciiProbe) decodeState(d *state.Decoder) {
+	t.CharSetProbe.decodeState(d)
+	t.lastClass = d.Range(tsciiOther, tsciiIllegal+1)
+	t.letters = d.Int()
+	t.marks = d.Int()
+	t.invalid = d.Int()
+}
+
+func (h *hzValidator) encodeState(e *state.Encoder) {
+	e.Bool(h.gb)
+	e.Bool(h.tilde)
+	e.Byte(h.lead)
+	e.Int(h.chars)
+	e.Int(h.invalid)
+	e.Int(h.closed)
+	e.Bool(h.letters)
+	e.Marshal(h.analysis)
+}
+
+func (h *hzValidator) decodeState(d *state.Decoder) {
+	h.gb = d.Bool()
+	h.tilde = d.Bool()
+	h.lead = d.Byte()
+	h.chars = d.Int()
+	h.invalid = d.Int()
+	h.closed = d.Int()
+	h.letters = d.Bool()
+	d.Unmarshal(h.analysis)
+}
+
+func (e *EscCharSetProbe) encodeState(enc *state.Encoder) {
+	e.CharSetProbe.encodeState(enc)
+	enc.Int(e.activeSmCount)
+	enc.String(e.detectedCharset)
+	enc.String(e.detectedLanguage)
+	enc.Int(len(e.codingSM))
+	for i, sm := range e.codingSM {
+		sm.encodeState(enc)
+		e.ruledOut[i].encodeState(enc)
+	}
+	enc.Bool(e.hz != nil)
+	if e.hz != nil {
+		e.hz.encodeState(enc)
+	}
+	enc.Bool(e.hzSeen)
+}
+
+func (e *EscCharSetProbe) decodeState(d *state.Decoder) {
+	e.CharSetProbe.decodeState(d)
+	e.activeSmCount = d.Range(0, len(e.codingSM)+1)
+	e.detectedCharset = d.String()
+	e.detectedLanguage = d.String()
+	if d.Int() != len(e.codingSM) {
+		d.Fail(state.ErrCorrupt)
+		return
+	}
+	for i, sm := range e.codingSM {
+		sm.decodeState(d)
+		e.ruledOut[i].decodeState(d)
+	}
+	if d.Bool() != (e.hz != nil) {
+		d.Fail(state.ErrCorrupt)
+		return
+	}
+	if e.hz != nil {
+		e.hz.decodeState(d)
+	}
+	e.hzSeen = d.Bool()
+}
+
+func (u *UTF1632Probe) encodeState(e *state.Encoder) {
+	u.CharSetProbe.encodeState(e)
+	e.Int(u.position)
+	for i := 0; i < 4; i++ {
+		e.Float(u.zerosAtMod[i])
+		e.Float(u.nonzeroAtMod[i])
+		e.Byte(u.quad[i])
+		u.ruledOut[i].encodeState(e)
+	}
+	e.Bool(u.invalidUtf16be)
+	e.Bool(u.invalidUtf16le)
+	e.Bool(u.invalidUtf32be)
+	e.Bool(u.invalidUtf32le)
+	e.Bool(u.firstHalfSurrogatePairDetected16be)
+	e.Bool(u.firstHalfSurrogatePairDetected16le)
+}
+
+func (u *UTF1632Probe) decodeState(d *state.Decoder) {
+	u.CharSetProbe.decodeState(d)
+	u.position = d.Range(0, math.MaxInt)
+	for i := 0; i < 4; i++ {
+		u.zerosAtMod[i] = d.Float()
+		u.nonzeroAtMod[i] = d.Float()
+		u.quad[i] = d.Byte()
+		u.ruledOut[i].decodeState(d)
+	}
+	u.invalidUtf16be = d.Bool()
+	u.invalidUtf16le = d.Bool()
+	u.invalidUtf32be = d.Bool()
+	u.invalidUtf32le = d.Bool()
+	u.firstHalfSurrogatePairDetected16be = d.Bool()
+	u.firstHalfSurrogatePairDetected16le = d.Bool()
+}
+
+func (s *utf16BlockStats) encodeState(e *state.Encoder) {
+	e.Int(s.units)
+	e.Int(s.ascii)
+	e.Int(s.nonASCII)
+	e.Int(s.invalid)
+	e.Ints(s.scripts[:])
+	e.Int(s.control)
+	e.Int(s.asciiPairs)
+	e.Bool(s.highSurrogate)
+}
+
+func (s *utf16BlockStats) decodeState(d *state.Decoder) {
+	s.units = d.Int()
+	s.ascii = d.Int()
+	s.nonASCII = d.Int()
+	s.invalid = d.Int()
+	decodeInts(d, s.scripts[:])
+	s.control = d.Int()
+	s.asciiPairs = d.Int()
+	s.highSurrogate = d.Bool()
+}
+
+func (u *UTF16BlockProbe) encodeState(e *state.Encoder) {
+	u.CharSetProbe.encodeState(e)
+	e.Int(u.position)
+	e.Byte(u.pending)
+	e.Bool(u.hasPending)
+	u.le.encodeState(e)
+	u.be.encodeState(e)
+}
+
+func (u *UTF16BlockProbe) decodeState(d *state.Decoder) {
+	u.CharSetProbe.decodeState(d)
+	u.position = d.Range(0, math.MaxInt)
+	u.pending = d.Byte()
+	u.hasPending = d.Bool()
+	u.le.decodeState(d)
+	u.be.decodeState(d)
+}
+
+func (b *BinaryProbe) encodeState(e *state.Encoder) {
+	b.CharSetProbe.encodeState(e)
+	e.Raw(b.head)
+	e.Int(b.total)
+	e.Int(b.nuls)
+	e.Int(b.padding)
+	e.Int(b.controls)
+	e.String(b.reason)
+	e.Int(b.length)
+	e.Int(b.high[0])
+	e.Int(b.high[1])
+}
+
+func (b *BinaryProbe) decodeState(d *state.Decoder) {
+	b.CharSetProbe.decodeState(d)
+	if b.head = d.Raw(); len(b.head) > 16 {
+		d.Fail(state.ErrCorrupt)
+	}
+	b.total = d.Int()
+	b.nuls = d.Int()
+	b.padding = d.Int()
+	b.controls = d.Int()
+	b.reason = d.String()
+	b.length = d.Range(0, math.MaxInt)
+	b.high[0] = d.Range(mixedHigh, 256)
+	b.high[1] = d.Range(mixedHigh, 256)
+}
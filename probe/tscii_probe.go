@@ -0,0 +1,121 @@
+package probe
+
+import (
+	"github.com/wlynxg/chardet/consts"
+)
+
+// TSCII character classes.
+const (
+	tsciiOther     = iota // ASCII, digits and punctuation
+	tsciiVowel            // independent vowel or aytham
+	tsciiConsonant        // bare consonant that may take a vowel sign
+	tsciiSyllable         // ligature of a consonant with a vowel sign or virama
+	tsciiSign             // vowel sign written after the consonant
+	tsciiPrefix           // vowel sign written before the consonant
+	tsciiIllegal          // undefined in TSCII
+)
+
+func tsciiClassOf(b byte) int {
+	switch {
+	case b < 0x80:
+		return tsciiOther
+	case b >= 0x83 && b <= 0x87, b >= 0xB8 && b <= 0xC9:
+		return tsciiConsonant
+	case b == 0x82, b >= 0x88 && b <= 0x8C, b >= 0x99 && b <= 0x9C, b >= 0xCA && b <= 0xFD:
+		return tsciiSyllable
+	case b >= 0xAB && b <= 0xB7, b == 0xFE:
+		return tsciiVowel
+	case b >= 0xA1 && b <= 0xA5, b == 0xAA:
+		return tsciiSign
+	case b >= 0xA6 && b <= 0xA8:
+		return tsciiPrefix
+	case b == 0xA0, b == 0xFF:
+		return tsciiIllegal
+	default:
+		return tsciiOther
+	}
+}
+
+// TsciiProbe recognises Tamil text in TSCII 1.7. TSCII is a glyph encoding
+// whose bytes stand for letters, whole syllables and vowel signs, and the
+// signs ெ, ே and ை are written before their consonant. The probe checks
+// that vowel signs sit on consonants the way Tamil spelling requires.
+type TsciiProbe struct {
+	CharSetProbe
+
+	// how many letters to see before feeling confident of prediction
+	MinLetters int
+	// the minimum number of vowel signs per letter in Tamil text
+	MinMarkRatio float64
+
+	lastClass int
+	letters   int
+	marks     int
+	invalid   int
+}
+
+func NewTsciiProbe() *TsciiProbe {
+	p := &TsciiProbe{
+		CharSetProbe: NewCharSetProbe(consts.UnknownLangFilter),
+
+		MinLetters:   16,
+		MinMarkRatio: 0.2,
+	}
+	p.Reset()
+	return p
+}
+
+func (t *TsciiProbe) Reset() {
+	t.CharSetProbe.Reset()
+	t.lastClass = tsciiOther
+	t.letters = 0
+	t.marks = 0
+	t.invalid = 0
+}
+
+func (t *TsciiProbe) CharSetName() string {
+	return consts.TSCII
+}
+
+func (t *TsciiProbe) Language() string {
+	return consts.Tamil
+}
+
+func (t *TsciiProbe) Feed(buf []byte) consts.ProbingState {
+	for i, b := range buf {
+		class := tsciiClassOf(b)
+		switch class {
+		case tsciiIllegal:
+			t.decide(consts.NotMeProbingState, i, "illegal byte 0x%02X", b)
+			return t.state
+		case tsciiVowel, tsciiConsonant, tsciiSyllable:
+			t.letters++
+		}
+
+		switch {
+		case t.lastClass == tsciiPrefix:
+			// a prefix sign is always followed by its consonant
+			if class == tsciiConsonant {
+				t.marks++
+			} else {
+				t.invalid++
+			}
+		case class == tsciiSign:
+			if t.lastClass == tsciiConsonant {
+				t.marks++
+			} else {
+				t.invalid++
+			}
+		}
+		t.lastClass = class
+	}
+
+	if t.state == consts.DetectingProbingState && t.invalid > 16 && t.invalid > t.marks {
+		t.decide(consts.NotMeProbingState, len(buf), "%d misplaced signs outnumber %d attached ones", t.invalid, t.marks)
+	}
+	return t.state
+}
+
+func (t *TsciiProbe) GetConfidence() float64 {
+	return indicConfidence(t.letters, t.marks, t.invalid, t.MinLetters, t.MinMarkRatio)
+}
@@ -0,0 +1,88 @@
+package probe
+
+// Tuning overrides the thresholds of probes. A zero field leaves the default of
+// each probe in place.
+type Tuning struct {
+	// ShortcutThreshold is the confidence at which a multi-byte probe stops
+	// early with its answer, 0.95 by default
+	ShortcutThreshold float64
+	// SampleSize is how many of the most frequent letters of a language the
+	// single-byte probes count sequences of, 64 by default and at most
+	SampleSize int
+	// PositiveShortcutThreshold is the confidence at which a single-byte probe
+	// stops early with its answer, 0.95 by default
+	PositiveShortcutThreshold float64
+	// NegativeShortcutThreshold is the confidence under which a single-byte
+	// probe gives up early, 0.05 by default
+	NegativeShortcutThreshold float64
+	// EnoughDataThreshold is how many characters the character distribution
+	// of a multi-byte probe needs before it may stop early, 1024 by default
+	EnoughDataThreshold int
+	// MinCharsForDetection is how many characters UTF1632Probe scans before
+	// it may recognise UTF-16 or UTF-32, 4 by default
+	MinCharsForDetection int
+}
+
+// tuner is implemented by the probes that have thresholds to tune.
+type tuner interface {
+	tune(t Tuning)
+}
+
+// Tune applies t to p and, for group and wrapping probes, to the probes they
+// hold.
+func Tune(p Probe, t Tuning) {
+	if tp, ok := p.(tuner); ok {
+		tp.tune(t)
+	}
+}
+
+func (p *CharSetProbe) tune(t Tuning) {
+	if t.ShortcutThreshold != 0 {
+		p.ShortcutThreshold = t.ShortcutThreshold
+	}
+}
+
+func (c *CharSetGroupProbe) tune(t Tuning) {
+	c.CharSetProbe.tune(t)
+	for _, p := range c.probes {
+		if p != nil {
+			Tune(p, t)
+		}
+	}
+}
+
+func (m *MultiByteCharSetProbe) tune(t Tuning) {
+	m.CharSetProbe.tune(t)
+	if a, ok := m.distributionAnalyzer.(interface{ SetEnoughDataThreshold(int) }); ok && t.EnoughDataThreshold != 0 {
+		a.SetEnoughDataThreshold(t.EnoughDataThreshold)
+	}
+}
+
+func (s *SingleByteCharSetProbe) tune(t Tuning) {
+	if t.SampleSize != 0 {
+		s.SampleSize = t.SampleSize
+		s.SBEnoughRelThreshold = t.SampleSize * t.SampleSize / 4
+	}
+	if t.PositiveShortcutThreshold != 0 {
+		s.PositiveShortcutThreshold = t.PositiveShortcutThreshold
+	}
+	if t.NegativeShortcutThreshold != 0 {
+		s.NegativeShortcutThreshold = t.NegativeShortcutThreshold
+	}
+}
+
+func (m *MacCharSetProbe) tune(t Tuning) {
+	Tune(m.Probe, t)
+}
+
+func (u *UTF8Probe) tune(t Tuning) {
+	if t.ShortcutThreshold != 0 {
+		u.ShortcutThreshold = t.ShortcutThreshold
+	}
+}
+
+func (u *UTF1632Probe) tune(t Tuning) {
+	if t.MinCharsForDetection != 0 {
+		u.MinCharsForDetection = float64(t.MinCharsForDetection)
+	}
+}
@@ -21,6 +21,9 @@ type UTF1632Probe struct {
 	invalidUtf32be, invalidUtf32le     bool
 	firstHalfSurrogatePairDetected16be bool
 	firstHalfSurrogatePairDetected16le bool
+
+	// why each of UTF-32BE, UTF-32LE, UTF-16BE and UTF-16LE was ruled out
+	ruledOut [4]Verdict
 }
 
 func NewUTF1632Probe() *UTF1632Probe {
@@ -56,6 +59,7 @@ func (u *UTF1632Probe) Reset() {
 	u.invalidUtf32le = false
 	u.firstHalfSurrogatePairDetected16be = false
 	u.firstHalfSurrogatePairDetected16le = false
+	u.ruledOut = [4]Verdict{}
 }
 
 func (u *UTF1632Probe) CharSetName() string {
@@ -175,13 +179,15 @@ func (u *UTF1632Probe) validateUtf16Characters(quard []byte) {
 }
 
 func (u *UTF1632Probe) Feed(buf []byte) consts.ProbingState {
-	for _, b := range buf {
+	for i, b := range buf {
 		mod4 := u.position % 4
 		u.quad[mod4] = b
 		if mod4 == 3 {
+			was := [4]bool{u.invalidUtf32be, u.invalidUtf32le, u.invalidUtf16be, u.invalidUtf16le}
 			u.validateUtf32Characters(u.quad[:])
 			u.validateUtf16Characters(u.quad[:2])
 			u.validateUtf16Characters(u.quad[2:4])
+			u.checkInvalid(was, i)
 		}
 
 		if b == 0 {
@@ -200,11 +206,13 @@ func (u *UTF1632Probe) State() consts.ProbingState {
 		return u.state
 	}
 
+	// the probe is fed from the start of the input, so position is where
+	// the last buffer ended
 	if u.GetConfidence() > 0.80 {
-		u.state = consts.FoundItProbingState
+		u.decide(consts.FoundItProbingState, u.position-u.offset, "zero bytes placed as in %s", u.CharSetName())
 	} else if u.position > 4*1024 {
 		// if we get to 4kb into the file, and we can't conclude it's UTF, let's give up
-		u.state = consts.NotMeProbingState
+		u.decide(consts.NotMeProbingState, u.position-u.offset, "no UTF-16 or UTF-32 pattern of zero bytes in the first 4 KiB")
 	}
 	return u.state
 }
@@ -0,0 +1,291 @@
+package probe
+
+import (
+	"github.com/wlynxg/chardet/consts"
+)
+
+// utf16Script groups the high byte of a UTF-16 code unit into the Unicode
+// blocks that real text is written in.
+type utf16Script byte
+
+const (
+	rareUTF16Script    utf16Script = iota // private use, unassigned or seldom used blocks
+	neutralUTF16Script                    // ASCII, Latin, punctuation and CJK symbols
+	greekUTF16Script
+	cyrillicUTF16Script
+	hebrewUTF16Script
+	arabicUTF16Script
+	indicUTF16Script
+	thaiUTF16Script
+	georgianUTF16Script
+	cjkUTF16Script
+	hangulUTF16Script
+	utf16ScriptNum
+)
+
+// utf16ScriptOf returns the script block of a code unit whose high byte is hi.
+func utf16ScriptOf(hi byte) utf16Script {
+	switch {
+	case hi <= 0x02, hi == 0x1E:
+		// Basic Latin, Latin-1, Latin Extended, IPA and Vietnamese
+		return neutralUTF16Script
+	case hi == 0x03:
+		return greekUTF16Script
+	case hi == 0x04:
+		return cyrillicUTF16Script
+	case hi == 0x05:
+		return hebrewUTF16Script
+	case hi >= 0x06 && hi <= 0x07:
+		return arabicUTF16Script
+	case hi >= 0x09 && hi <= 0x0D:
+		return indicUTF16Script
+	case hi == 0x0E:
+		return thaiUTF16Script
+	case hi == 0x10:
+		return georgianUTF16Script
+	case hi == 0x11, hi >= 0xAC && hi <= 0xD7:
+		return hangulUTF16Script
+	case hi >= 0x20 && hi <= 0x2F, hi >= 0x30 && hi <= 0x31, hi == 0xFF:
+		// General punctuation, symbols, CJK punctuation, kana and full-width forms
+		return neutralUTF16Script
+	case hi >= 0x34 && hi <= 0x9F, hi >= 0xF9 && hi <= 0xFA:
+		return cjkUTF16Script
+	default:
+		return rareUTF16Script
+	}
+}
+
+// utf16BlockStats accumulates code unit statistics for one byte order.
+type utf16BlockStats struct {
+	units    int
+	ascii    int
+	nonASCII int
+	invalid  int
+	scripts  [utf16ScriptNum]int
+	// control counts the non-ASCII code units with a C0 control byte other
+	// than tab, newline and carriage return, which text in other encodings
+	// never has
+	control int
+	// asciiPairs counts the code units whose two bytes are both ASCII text,
+	// which ASCII and UTF-8 text read as UTF-16 is mostly made of and which
+	// are no evidence for any block
+	asciiPairs int
+
+	highSurrogate bool
+}
+
+func (s *utf16BlockStats) add(hi, lo byte) {
+	s.units++
+
+	switch {
+	case hi >= 0xD8 && hi <= 0xDB:
+		if s.highSurrogate {
+			s.invalid++
+		}
+		s.highSurrogate = true
+		return
+	case hi >= 0xDC && hi <= 0xDF:
+		if s.highSurrogate {
+			// a well-formed surrogate pair, counted once as a neutral character
+			s.scripts[neutralUTF16Script] += 2
+			s.nonASCII++
+		} else {
+			s.invalid++
+		}
+		s.highSurrogate = false
+		return
+	}
+
+	if s.highSurrogate {
+		// the previous high surrogate was never completed
+		s.invalid++
+		s.highSurrogate = false
+	}
+
+	if hi == 0x00 {
+		switch {
+		case lo == '\t' || lo == '\n' || lo == '\r' || (lo >= 0x20 && lo <= 0x7E):
+			s.ascii++
+			s.scripts[neutralUTF16Script]++
+		case lo >= 0xA0:
+			s.nonASCII++
+			s.scripts[neutralUTF16Script]++
+		default:
+			// C0 and C1 control characters are not expected in text
+			s.invalid++
+		}
+		return
+	}
+
+	if isASCIIText(hi) && isASCIIText(lo) {
+		s.asciiPairs++
+		return
+	}
+
+	if hi == 0xFF && lo >= 0xFE {
+		// U+FFFE and U+FFFF are noncharacters
+		s.invalid++
+		return
+	}
+
+	s.nonASCII++
+	s.scripts[utf16ScriptOf(hi)]++
+	if isControl(hi) || isControl(lo) {
+		s.control++
+	}
+}
+
+// isASCIIText reports whether b is a printable ASCII byte, tab, newline or
+// carriage return.
+func isASCIIText(b byte) bool {
+	return b >= 0x20 && b <= 0x7E || b == '\t' || b == '\n' || b == '\r'
+}
+
+// isControl reports whether b is a C0 control byte other than tab, newline
+// and carriage return.
+func isControl(b byte) bool {
+	return b < 0x20 && b != '\t' && b != '\n' && b != '\r'
+}
+
+// ratio returns the share of code units that belong either to a neutral
+// block or to the single dominant script, along with the dominant count. The
+// code units made of two ASCII bytes are left out.
+func (s *utf16BlockStats) ratio() (float64, int) {
+	units := s.units - s.asciiPairs
+	if units <= 0 {
+		return 0.0, 0
+	}
+
+	dominant := 0
+	for script := greekUTF16Script; script < utf16ScriptNum; script++ {
+		dominant = max(dominant, s.scripts[script])
+	}
+	return float64(s.scripts[neutralUTF16Script]+dominant) / float64(units), dominant
+}
+
+// UTF16BlockProbe detects BOM-less UTF-16 text written in non-Latin scripts.
+//
+// UTF1632Probe relies on the high byte of most code units being zero, which
+// only holds for Latin text. For Chinese, Japanese, Korean, Cyrillic, Arabic
+// and similar scripts the high bytes are instead concentrated in a few Unicode
+// blocks (0x4E-0x9F for CJK ideographs, 0x04 for Cyrillic, ...). This probe
+// reads the input as both UTF-16LE and UTF-16BE and checks whether nearly all
+// code units fall into neutral blocks or a single dominant script.
+type UTF16BlockProbe struct {
+	CharSetProbe
+
+	// how many non-ASCII characters to scan before feeling confident of prediction
+	MinCharsForDetection int
+	// the minimum share of code units that must belong to plausible blocks
+	ExpectedRatio float64
+
+	position   int
+	pending    byte
+	hasPending bool
+	le, be     utf16BlockStats
+}
+
+func NewUTF16BlockProbe() *UTF16BlockProbe {
+	p := &UTF16BlockProbe{
+		CharSetProbe: NewCharSetProbe(consts.UnknownLangFilter),
+
+		MinCharsForDetection: 8,
+		ExpectedRatio:        0.94,
+	}
+	p.Reset()
+	return p
+}
+
+func (u *UTF16BlockProbe) Reset() {
+	u.CharSetProbe.Reset()
+	u.position = 0
+	u.pending = 0
+	u.hasPending = false
+	u.le = utf16BlockStats{}
+	u.be = utf16BlockStats{}
+}
+
+func (u *UTF16BlockProbe) CharSetName() string {
+	switch {
+	case u.isLikely(&u.le) && u.leRatio() >= u.beRatio():
+		return consts.UTF16Le
+	case u.isLikely(&u.be):
+		return consts.UTF16Be
+	default:
+		return consts.UTF16
+	}
+}
+
+func (u *UTF16BlockProbe) Language() string {
+	return ""
+}
+
+func (u *UTF16BlockProbe) leRatio() float64 {
+	r, _ := u.le.ratio()
+	return r
+}
+
+func (u *UTF16BlockProbe) beRatio() float64 {
+	r, _ := u.be.ratio()
+	return r
+}
+
+func (u *UTF16BlockProbe) isLikely(s *utf16BlockStats) bool {
+	if s.nonASCII < u.MinCharsForDetection {
+		return false
+	}
+	// Legacy encodings and UTF-8 never contain NUL bytes, so an ASCII
+	// character (newline, space, digit...) encoded as UTF-16 rules them out.
+	// Without one, as in CJK text without spaces, control bytes must show up
+	// the way they do in code units: as the high byte of most scripts below
+	// U+2000, or among the low bytes of CJK and Hangul, which spread over
+	// the whole range. The high bytes must also stay in the blocks of the
+	// script rather than among ASCII letters: at most a few CJK and kana code
+	// units are made of two ASCII bytes, while text in ASCII, UTF-8 or a
+	// legacy encoding is mostly made of them, whatever control bytes it has.
+	if s.ascii == 0 && (s.control*32 < s.nonASCII || s.asciiPairs*2 >= s.units) {
+		return false
+	}
+
+	r, dominant := s.ratio()
+	return dominant > 0 && r > u.ExpectedRatio
+}
+
+func (u *UTF16BlockProbe) Feed(buf []byte) consts.ProbingState {
+	for _, b := range buf {
+		if !u.hasPending {
+			u.pending = b
+			u.hasPending = true
+		} else {
+			u.le.add(b, u.pending)
+			u.be.add(u.pending, b)
+			u.hasPending = false
+		}
+		u.position++
+	}
+	return u.State()
+}
+
+func (u *UTF16BlockProbe) State() consts.ProbingState {
+	if u.state == consts.NotMeProbingState || u.state == consts.FoundItProbingState {
+		// terminal, decided states
+		return u.state
+	}
+
+	// the probe is fed from the start of the input, so position is where
+	// the last buffer ended
+	if u.GetConfidence() > 0.80 {
+		u.decide(consts.FoundItProbingState, u.position-u.offset, "code units of %s fall in the blocks of one script", u.CharSetName())
+	} else if u.position > 4*1024 {
+		// if we get to 4kb into the file, and we can't conclude it's UTF-16, let's give up
+		u.decide(consts.NotMeProbingState, u.position-u.offset, "no script dominates the UTF-16 code units of the first 4 KiB")
+	}
+	return u.state
+}
+
+func (u *UTF16BlockProbe) GetConfidence() float64 {
+	if u.isLikely(&u.le) || u.isLikely(&u.be) {
+		return 0.85
+	}
+	return 0.0
+}
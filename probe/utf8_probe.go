@@ -42,14 +42,14 @@ func (u *UTF8Probe) Reset() {
 
 func (u *UTF8Probe) Feed(data []byte) consts.ProbingState {
 loop:
-	for _, datum := range data {
+	for i, datum := range data {
 		codingState := u.codingSM.NextState(datum)
 		switch codingState {
 		case consts.ErrorMachineState:
-			u.state = consts.NotMeProbingState
+			u.decide(consts.NotMeProbingState, i, "illegal byte 0x%02X", datum)
 			break loop
 		case consts.ItsMeMachineState:
-			u.state = consts.FoundItProbingState
+			u.decide(consts.FoundItProbingState, i, "byte 0x%02X ends a sequence only %s has", datum, u.CharSetName())
 			break loop
 		case consts.StartMachineState:
 			if u.codingSM.CurrentCharLength() >= 2 {
@@ -60,7 +60,7 @@ loop:
 	}
 
 	if u.state == consts.DetectingProbingState && u.GetConfidence() > u.ShortcutThreshold {
-		u.state = consts.FoundItProbingState
+		u.decide(consts.FoundItProbingState, len(data), "confidence %.2f above shortcut threshold %.2f", u.GetConfidence(), u.ShortcutThreshold)
 	}
 	return u.state
 }
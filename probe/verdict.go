@@ -0,0 +1,135 @@
+package probe
+
+import (
+	"fmt"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+// Verdict tells where and why a probe, or one of the encodings it weighs,
+// stopped detecting.
+type Verdict struct {
+	// Offset is the position in the input of the byte that decided, or of
+	// the end of the buffer being fed when the decision was statistical
+	Offset int
+	// Reason explains the decision. It is empty while still detecting.
+	Reason string
+}
+
+// Candidate is one of the encodings a probe weighs on its own, such as the
+// escape sequence state machines of EscCharSetProbe.
+type Candidate struct {
+	Charset  string
+	Language string
+	// RuledOut reports that the input cannot be in Charset, for the reason
+	// given by Verdict
+	RuledOut bool
+	Verdict  Verdict
+}
+
+// offsetter is implemented by the probes that place their verdicts in the
+// input.
+type offsetter interface {
+	setOffset(offset int)
+}
+
+// SetOffset tells p, and the probes it groups, the position in the input of
+// the next buffer fed, so that verdicts report where they were reached. The
+// position is otherwise counted from the first buffer fed after SetOffset.
+func SetOffset(p Probe, offset int) {
+	if o, ok := p.(offsetter); ok {
+		o.setOffset(offset)
+	}
+}
+
+func (p *CharSetProbe) setOffset(offset int) {
+	p.offset = offset
+}
+
+func (c *CharSetGroupProbe) setOffset(offset int) {
+	c.offset = offset
+	for _, p := range c.probes {
+		if p != nil {
+			SetOffset(p, offset)
+		}
+	}
+}
+
+func (m *MacCharSetProbe) setOffset(offset int) {
+	SetOffset(m.Probe, offset)
+}
+
+// memberOffset returns how far into the buffer being fed member p reached its
+// verdict, or fed if p does not tell.
+func (c *CharSetGroupProbe) memberOffset(p Probe, fed int) int {
+	if v, ok := p.(interface{ Verdict() Verdict }); ok && v.Verdict().Reason != "" {
+		return v.Verdict().Offset - c.offset
+	}
+	return fed
+}
+
+// Verdict returns where and why the probe stopped detecting.
+func (p *CharSetProbe) Verdict() Verdict {
+	return p.verdict
+}
+
+// Verdict returns where and why the wrapped probe stopped detecting, once
+// enough Mac-only bytes were seen to trust it.
+func (m *MacCharSetProbe) Verdict() Verdict {
+	v, ok := m.Probe.(interface{ Verdict() Verdict })
+	if !ok || m.State() == consts.DetectingProbingState {
+		return Verdict{}
+	}
+	return v.Verdict()
+}
+
+// decide ends detection in state, for the reason given, at offset bytes into
+// the buffer being fed.
+func (p *CharSetProbe) decide(state consts.ProbingState, offset int, format string, args ...any) {
+	p.state = state
+	p.verdict = Verdict{Offset: p.offset + offset, Reason: fmt.Sprintf(format, args...)}
+}
+
+// Candidates returns the escape sequences the probe looks for, those that
+// met an illegal byte being ruled out.
+func (e *EscCharSetProbe) Candidates() []Candidate {
+	candidates := make([]Candidate, 0, len(e.codingSM))
+	for i, machine := range e.codingSM {
+		if machine == nil {
+			continue
+		}
+		candidates = append(candidates, Candidate{
+			Charset:  machine.CodingStateMachine(),
+			Language: machine.Language(),
+			RuledOut: !machine.Active,
+			Verdict:  e.ruledOut[i],
+		})
+	}
+	return candidates
+}
+
+// utf1632Candidates are the encodings UTF1632Probe tells apart, in the order
+// of its ruledOut verdicts.
+var utf1632Candidates = [4]string{consts.UTF32Be, consts.UTF32Le, consts.UTF16Be, consts.UTF16Le}
+
+// Candidates returns the UTF-16 and UTF-32 byte orders the probe tells apart,
+// those that met an invalid code unit being ruled out.
+func (u *UTF1632Probe) Candidates() []Candidate {
+	invalid := [4]bool{u.invalidUtf32be, u.invalidUtf32le, u.invalidUtf16be, u.invalidUtf16le}
+	candidates := make([]Candidate, len(utf1632Candidates))
+	for i, charset := range utf1632Candidates {
+		candidates[i] = Candidate{Charset: charset, RuledOut: invalid[i], Verdict: u.ruledOut[i]}
+	}
+	return candidates
+}
+
+// checkInvalid records the verdict of the byte orders that the code units
+// ending offset bytes into the buffer made invalid.
+func (u *UTF1632Probe) checkInvalid(was [4]bool, offset int) {
+	now := [4]bool{u.invalidUtf32be, u.invalidUtf32le, u.invalidUtf16be, u.invalidUtf16le}
+	for i := range now {
+		if now[i] && !was[i] {
+			u.ruledOut[i] = Verdict{Offset: u.offset + offset, Reason: "invalid " + utf1632Candidates[i] + " code unit"}
+		}
+	}
+}
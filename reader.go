@@ -0,0 +1,152 @@
+package chardet
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Container names the compression format input was wrapped in.
+type Container string
+
+const (
+	Gzip  Container = "gzip"
+	Bzip2 Container = "bzip2"
+	Zlib  Container = "zlib"
+)
+
+// readChunk is how many bytes ReadFrom feeds the detector at a time.
+const readChunk = 32 << 10
+
+// ReadFrom feeds the detector from r until it is sure of the result or r is
+// exhausted. It implements io.ReaderFrom.
+func (u *UniversalDetector) ReadFrom(r io.Reader) (int64, error) {
+	var (
+		n   int64
+		buf = make([]byte, readChunk)
+	)
+	for {
+		m, err := r.Read(buf)
+		n += int64(m)
+		if m > 0 && !u.Feed(buf[:m]) {
+			return n, nil
+		}
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+}
+
+// DefaultMaxDecompressed is how many decompressed bytes DetectCompressed reads
+// when given no limit.
+const DefaultMaxDecompressed = 16 << 20
+
+// DetectReader detects the encoding of the text read from r with a detector
+// configured by opts. It stops reading as soon as the result is certain.
+func DetectReader(r io.Reader, opts ...Option) (Result, error) {
+	c := newConfig(opts)
+	if c.err != nil {
+		return Result{}, c.err
+	}
+
+	d := c.detector()
+	_, err := d.ReadFrom(r)
+	return d.GetResult(), err
+}
+
+// DetectCompressed is DetectReader for input that may be compressed: gzip,
+// bzip2 and zlib streams are recognised by their magic number and the
+// encoding of the decompressed payload is detected, reading at most limit bytes
+// of it. A limit of zero or less stands for DefaultMaxDecompressed.
+// Result.Container names the compression format.
+func DetectCompressed(r io.Reader, limit int64, opts ...Option) (Result, error) {
+	c := newConfig(opts)
+	if c.err != nil {
+		return Result{}, c.err
+	}
+
+	if limit <= 0 {
+		limit = DefaultMaxDecompressed
+	}
+	r, container, err := decompress(r, limit)
+	if err != nil {
+		return Result{}, err
+	}
+
+	d := c.detector()
+	_, err = d.ReadFrom(r)
+	res := d.GetResult()
+	res.Container = container
+	if err != nil && container != "" {
+		err = fmt.Errorf("chardet: %s: %w", container, err)
+	}
+	return res, err
+}
+
+// decompress returns a reader of the payload of r, cut off after limit bytes, if r
+// starts with the magic number of a gzip, bzip2 or zlib stream.
+func decompress(r io.Reader, limit int64) (io.Reader, Container, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(10)
+
+	var (
+		payload   io.Reader
+		container Container
+		err       error
+	)
+	switch {
+	case len(magic) >= 3 && magic[0] == 0x1F && magic[1] == 0x8B && magic[2] == 8:
+		container = Gzip
+		payload, err = gzip.NewReader(br)
+	case isBzip2Header(magic):
+		container = Bzip2
+		payload = bzip2.NewReader(br)
+	case len(magic) >= 2 && isZlibHeader(magic[0], magic[1]) && inflates(br):
+		container = Zlib
+		payload, err = zlib.NewReader(br)
+	default:
+		return br, "", nil
+	}
+	if err != nil {
+		return nil, container, fmt.Errorf("chardet: %s: %w", container, err)
+	}
+	return io.LimitReader(payload, limit), container, nil
+}
+
+// isBzip2Header reports whether head opens a bzip2 stream: "BZh", the block
+// size and the magic number of the first block or of the end of an empty
+// stream. "BZh" alone also starts some texts.
+func isBzip2Header(head []byte) bool {
+	if len(head) < 10 || string(head[:3]) != "BZh" || head[3] < '1' || head[3] > '9' {
+		return false
+	}
+	return string(head[4:10]) == "1AY&SY" || string(head[4:10]) == "\x17rE8P\x90"
+}
+
+// isZlibHeader reports whether cmf and flg open a zlib stream (RFC 1950): a
+// deflate stream with a window of at most 32K, no preset dictionary and a
+// valid header checksum.
+func isZlibHeader(cmf, flg byte) bool {
+	return cmf&0x0F == 8 && cmf>>4 <= 7 && flg&0x20 == 0 && (uint16(cmf)<<8|uint16(flg))%31 == 0
+}
+
+// inflates reports whether the start of br decompresses as a zlib stream.
+// The two header bytes are also the start of some texts, such as "x^2", which
+// the deflate data that follows them tells apart.
+func inflates(br *bufio.Reader) bool {
+	head, _ := br.Peek(br.Size())
+	zr, err := zlib.NewReader(bytes.NewReader(head))
+	if err != nil {
+		return false
+	}
+	_, err = io.Copy(io.Discard, io.LimitReader(zr, readChunk))
+	return err == nil || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,99 @@
+package chardet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+func TestDetectReader(t *testing.T) {
+	text, err := os.ReadFile("test/testdata/KOI8-R/_chromium_KOI8-R_with_no_encoding_specified.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gz, zl bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	gw.Write(text)
+	gw.Close()
+	zw := zlib.NewWriter(&zl)
+	zw.Write(text)
+	zw.Close()
+
+	// produced by bzip2 -9 from "Hello, World!\n"
+	bz := []byte("BZh91AY&SY\x99\xac\x22\x56\x00\x00\x02\x57\x80\x00\x10\x60\x04\x00\x40\x00\x80\x06\x04\x90\x00\x20\x00\x22\x06\x81\x90\x80\x69\xa6\x89\x18\x6a\xce\xa4\x19\x6f\x8b\xb9\x22\x9c\x28\x48\x4c\xd6\x11\x2b\x00")
+
+	tests := []struct {
+		name      string
+		buf       []byte
+		container Container
+		charset   string
+	}{
+		{"plain", text, "", consts.Koi8R},
+		{"gzip", gz.Bytes(), Gzip, consts.Koi8R},
+		{"zlib", zl.Bytes(), Zlib, consts.Koi8R},
+		{"bzip2", bz, Bzip2, consts.CanonicalCharset(consts.Ascii)},
+		{"zlib-like text", []byte("x^2 + y^2 = r^2"), "", consts.CanonicalCharset(consts.Ascii)},
+		{"bzip2-like text", []byte("BZh is how bzip2 data starts."), "", consts.CanonicalCharset(consts.Ascii)},
+	}
+
+	for _, tt := range tests {
+		res, err := DetectCompressed(bytes.NewReader(tt.buf), 0)
+		if err != nil {
+			t.Errorf("DetectCompressed(%s) failed: %v", tt.name, err)
+			continue
+		}
+		if res.Container != tt.container || res.Charset != tt.charset {
+			t.Errorf("DetectCompressed(%s) = %+v, want %s in %q", tt.name, res, tt.charset, tt.container)
+		}
+	}
+
+	// without decompression the compressed bytes are binary data
+	if res, err := DetectReader(bytes.NewReader(gz.Bytes())); err != nil || !res.Binary {
+		t.Errorf("DetectReader(gzip) without decompression = %+v, %v, want binary", res, err)
+	}
+}
+
+func TestDetectCompressedLimit(t *testing.T) {
+	// the text comes after a megabyte of NUL padding
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	gw.Write(bytes.Repeat([]byte{0}, 1<<20))
+	gw.Write([]byte("Служба запущена и ожидает подключений на порту 8080."))
+	gw.Close()
+
+	tests := []struct {
+		limit   int64
+		charset string
+	}{
+		{4096, consts.CanonicalCharset(consts.Ascii)},
+		{0, consts.UTF8},
+	}
+
+	for _, tt := range tests {
+		res, err := DetectCompressed(bytes.NewReader(gz.Bytes()), tt.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Container != Gzip || res.Charset != tt.charset {
+			t.Errorf("DetectCompressed(limit %d) = %+v, want %s", tt.limit, res, tt.charset)
+		}
+	}
+}
+
+func TestDetectCompressedCorrupt(t *testing.T) {
+	_, err := DetectCompressed(strings.NewReader("\x1f\x8b\x08\x00garbage"), 0)
+	if err == nil {
+		t.Fatal("DetectCompressed(corrupt gzip) succeeded")
+	}
+	if errors.Is(err, io.EOF) {
+		t.Errorf("DetectCompressed(corrupt gzip) = %v, want a gzip error", err)
+	}
+}
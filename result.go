@@ -1,6 +1,9 @@
 package chardet
 
-import "github.com/wlynxg/chardet/consts"
+import (
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/declaration"
+)
 
 func newResult(name string, confidence float64, language string) Result {
 	charset := consts.CanonicalCharset(name)
@@ -10,5 +13,16 @@ func newResult(name string, confidence float64, language string) Result {
 		Charset:    charset,
 		Confidence: confidence,
 		Language:   language,
+		Source:     SourceStatistics,
 	}
 }
+
+// Matches reports whether text detected as r reads the same when decoded with
+// the encoding label names. Labels are compared as in the WHATWG Encoding
+// Standard, so that "latin1" matches a Windows-1252 result.
+func (r Result) Matches(label string) bool {
+	if name, ok := declaration.Normalize(label); ok {
+		label = name
+	}
+	return declarationAgrees(r, label)
+}
@@ -24,3 +24,25 @@ func TestNewResultSetsCharset(t *testing.T) {
 		}
 	}
 }
+
+func TestResultMatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		label string
+		want  bool
+	}{
+		{consts.Windows1252, "latin1", true},
+		{consts.ISO88591, "ISO-8859-1", true},
+		{consts.Ascii, "koi8-r", true},
+		{consts.Ascii, "utf-16le", false},
+		{consts.CP949, "euc-kr", true},
+		{consts.Windows1251, "koi8-r", false},
+		{consts.MacCyrillic, "MacCyrillic", true},
+	}
+
+	for _, tt := range tests {
+		if got := newResult(tt.name, 1.0, "").Matches(tt.label); got != tt.want {
+			t.Errorf("%s.Matches(%q) = %v, want %v", tt.name, tt.label, got, tt.want)
+		}
+	}
+}
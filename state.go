@@ -0,0 +1,338 @@
+package chardet
+
+import (
+	"bytes"
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/wlynxg/chardet/consts"
+	"github.com/wlynxg/chardet/declaration"
+	"github.com/wlynxg/chardet/internal/state"
+	"github.com/wlynxg/chardet/probe"
+)
+
+// stateMagic starts every encoded detector, followed by a byte holding the
+// version of the encoding.
+const stateMagic = "chardet"
+
+// stateVersion is the version of the encoding, bumped whenever a release
+// changes it. Version 1 holds the settings of the detector, the input seen so
+// far with the result, and the state of every probe created, the charset
+// probes preceded by the settings they were created with.
+const stateVersion = 1
+
+// ErrCorruptState reports state that UnmarshalBinary cannot decode.
+var ErrCorruptState = state.ErrCorrupt
+
+// ErrStateVersion reports state encoded in a version of the encoding that
+// UnmarshalBinary does not read, by an older or newer release of the package.
+var ErrStateVersion = errors.New("chardet: unsupported detector state version")
+
+// MarshalBinary encodes the settings of the detector and what it has learned
+// from the data fed so far, so that detection can be paused and resumed later,
+// or in another process, with UnmarshalBinary. Extractors, Logger and
+// Observer are not encoded.
+func (u *UniversalDetector) MarshalBinary() ([]byte, error) {
+	e := state.Encoder{}
+
+	// settings
+	e.Int(int(u.filter))
+	e.Float(u.MinimumThreshold)
+	encodeIsoWinMap(&e, u.IsoWinMap)
+	e.Bool(u.PreDecode)
+	e.Bool(u.KeepTerminalEscapes)
+	e.Strings(u.Charsets)
+	e.Strings(u.ExcludeCharsets)
+	e.Int(len(u.Hints))
+	for _, h := range u.Hints {
+		e.String(h.Charset)
+		e.String(h.Language)
+		e.Float(h.Weight)
+	}
+	encodeTuning(&e, u.Tuning)
+
+	// input seen so far
+	e.Bool(u.done)
+	e.Bool(u.gotData)
+	e.Bool(u.hasWinBytes)
+	e.Bool(u.hasNonISO88598Bytes)
+	e.Int(u.fed)
+	e.Int(u.textFed)
+	e.Raw(u.lastChars)
+	e.Raw(u.head)
+	e.Raw(u.ascii)
+	e.Int(u.ansi.state)
+	e.Int(u.ansi.osc)
+	e.Bool(u.checked)
+	e.Byte(byte(u.inputState))
+	encodeResult(&e, u.result)
+
+	// probes, which UnmarshalBinary creates again from the settings
+	e.Bool(u.escCharsetProbe != nil)
+	if u.escCharsetProbe != nil {
+		encodeProbe(&e, u.escCharsetProbe)
+	}
+	e.Bool(u.utf1632Probe != nil)
+	if u.utf1632Probe != nil {
+		encodeProbe(&e, u.utf1632Probe)
+	}
+	e.Bool(u.utf16BlockProbe != nil)
+	if u.utf16BlockProbe != nil {
+		encodeProbe(&e, u.utf16BlockProbe)
+	}
+	e.Bool(u.binaryProbe != nil)
+	if u.binaryProbe != nil {
+		encodeProbe(&e, u.binaryProbe)
+	}
+	e.Int(len(u.charsetProbes))
+	if len(u.charsetProbes) > 0 {
+		e.Strings(u.probeSettings.charsets)
+		e.Strings(u.probeSettings.excludeCharsets)
+		encodeIsoWinMap(&e, u.probeSettings.isoWinMap)
+		encodeTuning(&e, u.probeSettings.tuning)
+	}
+	for _, p := range u.charsetProbes {
+		encodeProbe(&e, p)
+	}
+
+	if err := e.Err(); err != nil {
+		return nil, err
+	}
+	data := append([]byte(stateMagic), stateVersion)
+	return append(data, e.Bytes()...), nil
+}
+
+// UnmarshalBinary restores the state encoded by MarshalBinary, settings
+// included, into u, whose Extractors, Logger and Observer are kept. Feeding u
+// the rest of the data then gives the result the encoded detector would have
+// given. u is left unchanged when data cannot be decoded.
+func (u *UniversalDetector) UnmarshalBinary(data []byte) error {
+	if !bytes.HasPrefix(data, []byte(stateMagic)) || len(data) == len(stateMagic) {
+		return errors.New("chardet: unknown detector state format")
+	}
+	if data[len(stateMagic)] != stateVersion {
+		return ErrStateVersion
+	}
+	d := state.NewDecoder(data[len(stateMagic)+1:])
+	v := UniversalDetector{Extractors: u.Extractors, Logger: u.Logger, Observer: u.Observer}
+
+	v.filter = consts.LangFilter(d.Int())
+	v.MinimumThreshold = d.Float()
+	v.IsoWinMap = decodeIsoWinMap(d)
+	v.PreDecode = d.Bool()
+	v.KeepTerminalEscapes = d.Bool()
+	v.Charsets = d.Strings()
+	v.ExcludeCharsets = d.Strings()
+	if n := d.Count(); n > 0 {
+		v.Hints = make([]Hint, n)
+		for i := range v.Hints {
+			v.Hints[i].Charset = d.String()
+			v.Hints[i].Language = d.String()
+			v.Hints[i].Weight = d.Float()
+		}
+	}
+	v.Tuning = decodeTuning(d)
+
+	v.done = d.Bool()
+	v.gotData = d.Bool()
+	v.hasWinBytes = d.Bool()
+	v.hasNonISO88598Bytes = d.Bool()
+	v.fed = d.Int()
+	v.textFed = d.Int()
+	v.lastChars = append([]byte{}, d.Raw()...)
+	v.head = d.Raw()
+	v.ascii = d.Raw()
+	if len(v.head) > declaration.PrescanLength || len(v.ascii) > maxPreDecode {
+		d.Fail(ErrCorruptState)
+	}
+	v.ansi.state = d.Range(ansiGround, ansiOSCEscape+1)
+	v.ansi.osc = d.Range(0, math.MaxInt)
+	v.checked = d.Bool()
+	v.inputState = consts.InputState(d.Byte())
+	v.result = decodeResult(d)
+
+	if d.Bool() {
+		v.escCharsetProbe = probe.NewEscCharSetProbe(v.filter)
+		probe.SetLogger(v.escCharsetProbe, v.Logger)
+		probe.SetObserver(v.escCharsetProbe, v.Observer)
+		decodeProbe(d, v.escCharsetProbe)
+	}
+	if d.Bool() {
+		v.utf1632Probe = probe.NewUTF1632Probe()
+		probe.Tune(v.utf1632Probe, v.Tuning)
+		decodeProbe(d, v.utf1632Probe)
+	}
+	if d.Bool() {
+		v.utf16BlockProbe = probe.NewUTF16BlockProbe()
+		decodeProbe(d, v.utf16BlockProbe)
+	}
+	if d.Bool() {
+		v.binaryProbe = probe.NewBinaryProbe()
+		decodeProbe(d, v.binaryProbe)
+	}
+	if n := d.Count(); n > 0 {
+		v.probeSettings.charsets = d.Strings()
+		v.probeSettings.excludeCharsets = d.Strings()
+		v.probeSettings.isoWinMap = decodeIsoWinMap(d)
+		v.probeSettings.tuning = decodeTuning(d)
+		// the settings decide which probes there are, so they must agree
+		if v.charsetProbes = v.newCharsetProbesWith(v.probeSettings); len(v.charsetProbes) != n {
+			d.Fail(ErrCorruptState)
+		}
+		for _, p := range v.charsetProbes {
+			decodeProbe(d, p)
+		}
+	}
+
+	if err := d.Err(); err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
+
+// Clone returns a deep copy of the detector, probes included, so that the data
+// fed so far can be followed by several alternative continuations without
+// being fed again. The copy shares the models of the probes, which are never
+// written, and the Logger and Observer of u.
+func (u *UniversalDetector) Clone() (*UniversalDetector, error) {
+	c := *u
+	c.IsoWinMap = maps.Clone(u.IsoWinMap)
+	c.Extractors = slices.Clone(u.Extractors)
+	c.Charsets = slices.Clone(u.Charsets)
+	c.ExcludeCharsets = slices.Clone(u.ExcludeCharsets)
+	c.Hints = slices.Clone(u.Hints)
+	c.lastChars = slices.Clone(u.lastChars)
+	c.head = slices.Clone(u.head)
+	c.ascii = slices.Clone(u.ascii)
+
+	var err error
+	if u.escCharsetProbe != nil {
+		if c.escCharsetProbe, err = probe.Clone(u.escCharsetProbe); err != nil {
+			return nil, err
+		}
+	}
+	if u.utf1632Probe != nil {
+		p, err := probe.Clone(u.utf1632Probe)
+		if err != nil {
+			return nil, err
+		}
+		c.utf1632Probe = p.(*probe.UTF1632Probe)
+	}
+	if u.utf16BlockProbe != nil {
+		p, err := probe.Clone(u.utf16BlockProbe)
+		if err != nil {
+			return nil, err
+		}
+		c.utf16BlockProbe = p.(*probe.UTF16BlockProbe)
+	}
+	if u.binaryProbe != nil {
+		p, err := probe.Clone(u.binaryProbe)
+		if err != nil {
+			return nil, err
+		}
+		c.binaryProbe = p.(*probe.BinaryProbe)
+	}
+	c.charsetProbes = make([]probe.Probe, len(u.charsetProbes))
+	for i, p := range u.charsetProbes {
+		if c.charsetProbes[i], err = probe.Clone(p); err != nil {
+			return nil, err
+		}
+	}
+	return &c, nil
+}
+
+func encodeIsoWinMap(e *state.Encoder, m map[string]string) {
+	isos := make([]string, 0, len(m))
+	for iso := range m {
+		isos = append(isos, iso)
+	}
+	sort.Strings(isos)
+	e.Int(len(isos))
+	for _, iso := range isos {
+		e.String(iso)
+		e.String(m[iso])
+	}
+}
+
+func decodeIsoWinMap(d *state.Decoder) map[string]string {
+	n := d.Count()
+	if n == 0 {
+		return nil
+	}
+	m := make(map[string]string, n)
+	for i := 0; i < n; i++ {
+		iso := d.String()
+		m[iso] = d.String()
+	}
+	return m
+}
+
+func encodeTuning(e *state.Encoder, t probe.Tuning) {
+	e.Float(t.ShortcutThreshold)
+	e.Int(t.SampleSize)
+	e.Float(t.PositiveShortcutThreshold)
+	e.Float(t.NegativeShortcutThreshold)
+	e.Int(t.EnoughDataThreshold)
+	e.Int(t.MinCharsForDetection)
+}
+
+func decodeTuning(d *state.Decoder) probe.Tuning {
+	var t probe.Tuning
+	t.ShortcutThreshold = d.Float()
+	t.SampleSize = d.Int()
+	t.PositiveShortcutThreshold = d.Float()
+	t.NegativeShortcutThreshold = d.Float()
+	t.EnoughDataThreshold = d.Int()
+	t.MinCharsForDetection = d.Int()
+	return t
+}
+
+func encodeProbe(e *state.Encoder, p probe.Probe) {
+	b, err := probe.MarshalState(p)
+	if err != nil {
+		e.Fail(err)
+	}
+	e.Raw(b)
+}
+
+func decodeProbe(d *state.Decoder, p probe.Probe) {
+	if err := probe.UnmarshalState(p, d.Raw()); err != nil {
+		d.Fail(err)
+	}
+}
+
+func encodeResult(e *state.Encoder, r Result) {
+	e.String(r.Encoding)
+	e.String(r.Charset)
+	e.Float(r.Confidence)
+	e.String(r.Language)
+	e.Bool(r.Visual)
+	e.String(string(r.Source))
+	e.String(r.Declared)
+	e.Bool(r.Conflict)
+	e.Bool(r.Binary)
+	e.String(r.Reason)
+	e.String(string(r.Container))
+	e.String(string(r.Wrapping))
+}
+
+func decodeResult(d *state.Decoder) Result {
+	var r Result
+	r.Encoding = d.String()
+	r.Charset = d.String()
+	r.Confidence = d.Float()
+	r.Language = d.String()
+	r.Visual = d.Bool()
+	r.Source = Source(d.String())
+	r.Declared = d.String()
+	r.Conflict = d.Bool()
+	r.Binary = d.Bool()
+	r.Reason = d.String()
+	r.Container = Container(d.String())
+	r.Wrapping = Wrapping(d.String())
+	return r
+}
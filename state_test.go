@@ -0,0 +1,246 @@
+package chardet
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wlynxg/chardet/consts"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	dirs, err := os.ReadDir("test/testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		files, err := filepath.Glob(filepath.Join("test/testdata", dir.Name(), "*"))
+		if err != nil || len(files) == 0 {
+			continue
+		}
+		text, err := os.ReadFile(files[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		whole := NewUniversalDetector(consts.AllLangFilter)
+		for i := 0; i < len(text) && whole.Feed(text[i:min(i+256, len(text))]); i += 256 {
+		}
+		want := whole.GetResult()
+
+		// pause half way, at a chunk boundary, and resume in another detector
+		d := NewUniversalDetector(consts.AllLangFilter)
+		half := len(text) / 2 / 256 * 256
+		for i := 0; i < half && d.Feed(text[i:min(i+256, half)]); i += 256 {
+		}
+		saved, err := d.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: MarshalBinary() error: %v", files[0], err)
+		}
+		resumed := NewUniversalDetector(consts.AllLangFilter)
+		if err := resumed.UnmarshalBinary(saved); err != nil {
+			t.Fatalf("%s: UnmarshalBinary() error: %v", files[0], err)
+		}
+		if again, _ := resumed.MarshalBinary(); !bytes.Equal(again, saved) {
+			t.Errorf("%s: MarshalBinary() after UnmarshalBinary differs", files[0])
+		}
+		for i := half; i < len(text) && resumed.Feed(text[i:min(i+256, len(text))]); i += 256 {
+		}
+
+		if res := resumed.GetResult(); res != want {
+			t.Errorf("%s: resumed GetResult() = %+v, want %+v", files[0], res, want)
+		}
+	}
+}
+
+func TestMarshalBinarySettings(t *testing.T) {
+	d, err := New(WithLanguageFilter(consts.JapaneseLangFilter), WithCharsets("Shift_JIS", "EUC-JP"),
+		WithHints(Hint{Language: "Japanese", Weight: 2}), WithSampleSize(32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Feed([]byte("\x93\xfa\x96\x7b\x8c\xea"))
+	saved, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resumed := NewUniversalDetector(consts.AllLangFilter)
+	if err := resumed.UnmarshalBinary(saved); err != nil {
+		t.Fatal(err)
+	}
+	if resumed.filter != d.filter || len(resumed.Charsets) != 2 || len(resumed.Hints) != 1 || resumed.Tuning != d.Tuning {
+		t.Errorf("UnmarshalBinary() settings = %v %v %v %+v, want %v %v %v %+v",
+			resumed.filter, resumed.Charsets, resumed.Hints, resumed.Tuning, d.filter, d.Charsets, d.Hints, d.Tuning)
+	}
+	if res, want := resumed.GetResult(), d.GetResult(); res != want {
+		t.Errorf("resumed GetResult() = %+v, want %+v", res, want)
+	}
+
+	for _, data := range [][]byte{nil, []byte("garbage"), saved[:len(saved)-1], append(saved, 0)} {
+		if err := resumed.UnmarshalBinary(data); err == nil {
+			t.Errorf("UnmarshalBinary(%q) succeeded, want an error", data)
+		}
+	}
+	if err := resumed.UnmarshalBinary(saved[:len(saved)/2]); !errors.Is(err, ErrCorruptState) {
+		t.Errorf("UnmarshalBinary(truncated) = %v, want %v", err, ErrCorruptState)
+	}
+
+	// state of another version of the encoding
+	for _, version := range []byte{stateVersion - 1, stateVersion + 1} {
+		other := bytes.Clone(saved)
+		other[len(stateMagic)] = version
+		if err := resumed.UnmarshalBinary(other); !errors.Is(err, ErrStateVersion) {
+			t.Errorf("UnmarshalBinary(version %d) = %v, want %v", version, err, ErrStateVersion)
+		}
+	}
+}
+
+func TestClone(t *testing.T) {
+	prefix := []byte("<p>\xd0\x9f\xd1\x80\xd0\xb8\xd0\xb2\xd0\xb5\xd1\x82, \xd0\xbc\xd0\xb8\xd1\x80!</p>\n")
+	continuations := [][]byte{
+		bytes.Repeat([]byte("\xd0\x94\xd0\xbe\xd0\xb1\xd1\x80\xd0\xbe \xd0\xbf\xd0\xbe\xd0\xb6\xd0\xb0\xd0\xbb\xd0\xbe\xd0\xb2\xd0\xb0\xd1\x82\xd1\x8c. "), 20),
+		bytes.Repeat([]byte("\xc4\xee\xe1\xf0\xee \xef\xee\xe6\xe0\xeb\xee\xe2\xe0\xf2\xfc. "), 20),
+		[]byte(" plain ASCII"),
+	}
+
+	d := NewUniversalDetector(consts.AllLangFilter)
+	d.Feed(prefix)
+	for _, rest := range continuations {
+		fork, err := d.Clone()
+		if err != nil {
+			t.Fatal(err)
+		}
+		fork.Feed(rest)
+
+		want := NewUniversalDetector(consts.AllLangFilter)
+		want.Feed(prefix)
+		want.Feed(rest)
+		if res := fork.GetResult(); res != want.GetResult() {
+			t.Errorf("Clone() fed %q = %+v, want %+v", rest, res, want.GetResult())
+		}
+	}
+
+	// the forks leave the original untouched
+	want := NewUniversalDetector(consts.AllLangFilter)
+	want.Feed(prefix)
+	if res := d.GetResult(); res != want.GetResult() {
+		t.Errorf("GetResult() after Clone = %+v, want %+v", res, want.GetResult())
+	}
+}
+
+func TestCloneProbes(t *testing.T) {
+	// texts that reach every probe, each cloned halfway and both halves then
+	// fed the rest of another text
+	texts := [][]byte{
+		[]byte("\x93\xfa\x96\x7b\x8c\xea\x82\xcc\x95\xb6\x8f\xcd\x82\xc5\x82\xb7\x81\x42"),
+		[]byte("\xa4\xb3\xa4\xf3\xa4\xcb\xa4\xc1\xa4\xcf\xa1\xa2\xc0\xa4\xb3\xa6"),
+		[]byte("\xf9\xec\xe5\xed \xf2\xe5\xec\xed, \xee\xe4\xe5 \xf9\xec\xe5\xed\xea?"),
+		[]byte("caf\xe9 na\xefve \xe0 la cr\xe8me br\xfbl\xe9e"),
+		[]byte("~{<:Ky2;S{~} and ~{VPND~} again"),
+		[]byte("Hello there!\x1b$B$3$s$K$A$O\x1b(B"),
+		[]byte("Привет, мир! Как дела?"),
+		[]byte("h\x00e\x00l\x00l\x00o\x00 \x00w\x00o\x00r\x00l\x00d\x00"),
+		[]byte("\x00\x01\x02\x03binary\x00\x00\xff\xfe\x00\x10"),
+		[]byte("\xa4\xa4\xa4\xe5\xa6\x72\xbd\x58\xb0\xbb\xb4\xfa\xa1\x43"),
+		[]byte("\xb0\xa1\xb0\xa2 \xc7\xd1\xb1\xb9\xbe\xee \xb1\xdb\xc0\xda"),
+	}
+
+	for _, text := range texts {
+		for _, other := range texts {
+			half := len(text) / 2
+			d := NewUniversalDetector(consts.AllLangFilter)
+			d.Feed(text[:half])
+			fork, err := d.Clone()
+			if err != nil {
+				t.Fatal(err)
+			}
+			fork.Feed(other[len(other)/2:])
+			d.Feed(text[half:])
+
+			for _, tt := range []struct {
+				got  *UniversalDetector
+				a, b []byte
+			}{
+				{d, text[:half], text[half:]},
+				{fork, text[:half], other[len(other)/2:]},
+			} {
+				want := NewUniversalDetector(consts.AllLangFilter)
+				want.Feed(tt.a)
+				want.Feed(tt.b)
+				if got, want := tt.got.Explain().String(), want.Explain().String(); got != want {
+					t.Errorf("Explain() of %q then %q after Clone =\n%s\nwant\n%s", tt.a, tt.b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestCloneChangedSettings(t *testing.T) {
+	prefix := []byte("\xcf\xf0\xe8\xe2\xe5\xf2, \xec\xe8\xf0! ")
+	rest := bytes.Repeat([]byte("\xc4\xee\xe1\xf0\xee \xef\xee\xe6\xe0\xeb\xee\xe2\xe0\xf2\xfc. "), 20)
+
+	for _, change := range []func(d *UniversalDetector){
+		func(d *UniversalDetector) { d.Charsets = []string{"KOI8-R"} },
+		func(d *UniversalDetector) { d.ExcludeCharsets = []string{"Windows-1251"} },
+		func(d *UniversalDetector) { d.Charsets, d.ExcludeCharsets = nil, nil },
+	} {
+		d, err := New(WithCharsets("Windows-1251", "KOI8-R", "UTF-8"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		d.Feed(prefix)
+		change(d)
+
+		fork, err := d.Clone()
+		if err != nil {
+			t.Fatal(err)
+		}
+		fork.Feed(rest)
+		d.Feed(rest)
+		if res, want := fork.GetResult(), d.GetResult(); res != want {
+			t.Errorf("Clone() after a change of %v %v = %+v, want %+v", d.Charsets, d.ExcludeCharsets, res, want)
+		}
+	}
+}
+
+func FuzzUnmarshalBinary(f *testing.F) {
+	texts := [][]byte{
+		[]byte("\xcf\xf0\xe8\xe2\xe5\xf2, \xec\xe8\xf0! \xc4\xee\xe1\xf0\xee \xef\xee\xe6\xe0\xeb\xee\xe2\xe0\xf2\xfc."),
+		[]byte("\x93\xfa\x96\x7b\x8c\xea\x82\xcc\x95\xb6\x8f\xcd"),
+		[]byte("\xa4\xb3\xa4\xf3\xa4\xcb\xa4\xc1\xa4\xcf"),
+		[]byte("~{<:Ky2;S{~} and ~{VP~}"),
+		[]byte("\x1b$B$3$s$K$A$O\x1b(B"),
+		[]byte("h\x00e\x00l\x00l\x00o\x00"),
+		[]byte("caf\xe9 na\xefve \xe0 la cr\xe8me"),
+		[]byte("\xf9\xec\xe5\xed \xf2\xe5\xec\xed"),
+		[]byte("\x1b[1mbold\x1b[0m"),
+	}
+	for _, text := range texts {
+		d := NewUniversalDetector(consts.AllLangFilter)
+		d.Feed(text)
+		saved, err := d.MarshalBinary()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(saved)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		d := NewUniversalDetector(consts.AllLangFilter)
+		if err := d.UnmarshalBinary(data); err != nil {
+			return
+		}
+		for _, text := range texts {
+			d.Feed(text)
+		}
+		d.GetResult()
+		d.PeekAll()
+	})
+}